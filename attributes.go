@@ -2,12 +2,25 @@ package renderHTML
 
 import (
 	"fmt"
-	"slices"
+	"html"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // #region ATTRIBUTE
+
+// Boolean attributes, like Disabled, Required or Open, receive an optional
+// bool. Without it, or when it is true, the attribute is written without a
+// value; when it is false, the attribute is removed. This allows
+// Disabled(!editable) without an if statement.
+//
+// Enumerated attributes that accept "true" and "false", like SpellCheck and
+// Draggable, are written with their value instead.
+//
+// The other enumerated attributes, like Target or Loading, receive a string
+// type with a constant for each keyword, like TargetBlank or LoadingLazy. In
+// Strict mode, a value that is not a keyword is recorded as ErrInvalidValue.
 type attribute[T any] struct {
 	el *element
 	t  *T
@@ -18,6 +31,133 @@ func (p *attribute[T]) set(el *element, t *T) {
 	p.t = t
 }
 
+// urlAttributes are the attributes whose value is a URL. Their values are
+// sanitized so they can't use schemes like "javascript:".
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"cite":       true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"hx-delete":  true,
+	"hx-get":     true,
+	"hx-patch":   true,
+	"hx-post":    true,
+	"hx-put":     true,
+	"itemid":     true,
+	"manifest":   true,
+	"ping":       true,
+	"poster":     true,
+	"src":        true,
+	"xlink:href": true,
+}
+
+// attributeValueContext returns the escape context of the value of the named
+// attribute.
+func attributeValueContext(name string) escapeContext {
+	if urlAttributes[strings.ToLower(name)] {
+		return urlContext
+	}
+	return attributeContext
+}
+
+// validAttributeName reports whether name can be used as an attribute name.
+// Attribute names can't be empty and can't contain spaces, control
+// characters, quotes, ">", "/" or "=".
+//
+// https://html.spec.whatwg.org/multipage/syntax.html#attributes-2
+func validAttributeName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		switch {
+		case r <= ' ', r == 0x7f, r >= 0x80 && r <= 0x9f:
+			return false
+		case r == '"', r == '\'', r == '>', r == '/', r == '=':
+			return false
+		case r == unicode.ReplacementChar:
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseAttributes parses a list of attributes written as in HTML:
+//
+//	hx-post="/customer" hx-target='#div-one' hidden data-id=7
+//
+// Character references in the values are decoded, so an already escaped
+// value is not escaped twice when rendered. It stops at the first malformed
+// attribute, returning the attributes parsed until then and an error.
+func parseAttributes(s string) ([]attr, error) {
+	var attrs []attr
+	for {
+		s = strings.TrimLeft(s, " \t\n\r\f")
+		if s == "" {
+			return attrs, nil
+		}
+
+		i := strings.IndexAny(s, " \t\n\r\f=")
+		if i < 0 {
+			i = len(s)
+		}
+
+		a := attr{name: s[:i]}
+		if !validAttributeName(a.name) {
+			return attrs, fmt.Errorf("invalid attribute name %q", a.name)
+		}
+
+		s = strings.TrimLeft(s[i:], " \t\n\r\f")
+		if !strings.HasPrefix(s, "=") {
+			attrs = append(attrs, a)
+			continue
+		}
+
+		s = strings.TrimLeft(s[1:], " \t\n\r\f")
+		a.hasValue = true
+		switch {
+		case s == "":
+			return attrs, fmt.Errorf("missing value of attribute %q", a.name)
+		case s[0] == '"' || s[0] == '\'':
+			end := strings.IndexByte(s[1:], s[0])
+			if end < 0 {
+				return attrs, fmt.Errorf("unterminated value of attribute %q", a.name)
+			}
+			a.value = html.UnescapeString(s[1 : end+1])
+			s = s[end+2:]
+		default:
+			end := strings.IndexAny(s, " \t\n\r\f")
+			if end < 0 {
+				end = len(s)
+			}
+			a.value = html.UnescapeString(s[:end])
+			s = s[end:]
+		}
+
+		attrs = append(attrs, a)
+	}
+}
+
+// addRawAttributes adds the attributes written in s to the element. Invalid
+// attributes are discarded, and recorded as ErrInvalidAttribute in Strict
+// mode.
+func (p *element) addRawAttributes(s string) {
+	attrs, err := parseAttributes(s)
+	if err != nil && Strict {
+		p.addError(fmt.Errorf("%w: %v", ErrInvalidAttribute, err))
+	}
+	for _, a := range attrs {
+		if a.hasValue {
+			p.addAttribute(a.name, a.value)
+		} else {
+			p.addAttribute(a.name)
+		}
+	}
+}
+
 // #region EXTERNAL ATTRS
 
 type attrExternalAttributes[T any] struct {
@@ -38,17 +178,30 @@ type attrExternalAttributes[T any] struct {
 //
 // If it implements the fmt.Stringer interface, which has the String() method,
 // the affected element will include all external attributes as a single string.
+//
+// Each string is parsed as a list of attributes written as in HTML, for
+// example `hx-post="/customer" hx-target="#div-one"`. The values are escaped
+// when rendered, and attributes with an invalid name or a malformed value are
+// discarded instead of being injected in the element.
 func (p *attrExternalAttributes[T]) AddAttributes(attrs ...any) *T {
 	for _, value := range attrs {
 		switch v := value.(type) {
+		case interface{ attributes() []attr }:
+			for _, a := range v.attributes() {
+				if a.hasValue {
+					p.el.addAttribute(a.name, a.value)
+				} else {
+					p.el.addAttribute(a.name)
+				}
+			}
 		case interface{ GetAttributes() []string }:
 			for _, s := range v.GetAttributes() {
-				p.el.addAttribute(s)
+				p.el.addRawAttributes(s)
 			}
 		case fmt.Stringer:
-			p.el.addAttribute(v.String())
+			p.el.addRawAttributes(v.String())
 		case string:
-			p.el.addAttribute(v)
+			p.el.addRawAttributes(v)
 		default:
 			continue
 		}
@@ -71,6 +224,44 @@ type attrGlobal[T any] struct {
 	attribute[T]
 }
 
+// #region G: editing
+
+// SetAttribute sets the value of an attribute, replacing the previous value
+// when the attribute was already added. Without a value, the attribute is
+// written without it, like a boolean attribute.
+//
+// The class and style attributes replace all the classes or styles.
+//
+// Example:
+//
+//	layout.ById("logo").(*ImgElement).SetAttribute("src", "/img/logo-dark.svg")
+func (p *attrGlobal[T]) SetAttribute(name string, value ...any) *T {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "class":
+		p.el.classes = nil
+		for _, v := range value {
+			p.el.addClasses(fmt.Sprintf("%v", v))
+		}
+	case "style":
+		p.el.styles = nil
+		for _, v := range value {
+			p.el.addStyles(fmt.Sprintf("%v", v))
+		}
+	default:
+		p.el.setAttribute(name, value...)
+	}
+	return p.t
+}
+
+// RemoveAttribute removes the attributes. Removing class or style removes all
+// the classes or styles.
+func (p *attrGlobal[T]) RemoveAttribute(name ...string) *T {
+	for _, n := range name {
+		p.el.removeAttribute(n)
+	}
+	return p.t
+}
+
 // #region G: accesskey
 
 // AccessKey is an global attribute: specifies a shortcut key to activate or
@@ -96,6 +287,22 @@ func (p *attrGlobal[T]) Aria(name string, value string) *T {
 
 // #region G: autocapitalize
 
+// AutoCapitalize is a value of the autocapitalize attribute.
+type AutoCapitalize string
+
+const (
+	// AutoCapitalizeOff does not capitalize any text ("none" is the same).
+	AutoCapitalizeOff  AutoCapitalize = "off"
+	AutoCapitalizeNone AutoCapitalize = "none"
+	// AutoCapitalizeSentences capitalizes the first character of each sentence ("on" is the same).
+	AutoCapitalizeSentences AutoCapitalize = "sentences"
+	AutoCapitalizeOn        AutoCapitalize = "on"
+	// AutoCapitalizeWords capitalizes the first character of each word.
+	AutoCapitalizeWords AutoCapitalize = "words"
+	// AutoCapitalizeCharacters capitalizes every character.
+	AutoCapitalizeCharacters AutoCapitalize = "characters"
+)
+
 // AutoCapitalize is an global attribute: controls how text input is capitalized.
 // The autocapitalize global attribute is an enumerated attribute that controls
 // whether inputted text is automatically capitalized and, if so, in what manner.
@@ -115,8 +322,22 @@ func (p *attrGlobal[T]) Aria(name string, value string) *T {
 //   - sentences or on: automatically capitalize the first character of each sentence.
 //   - words: automatically capitalize the first character of each word.
 //   - characters: automatically capitalize every character.
-func (p *attrGlobal[T]) AutoCapitalize(value string) *T {
-	p.el.addAttribute("autocapitalize", value)
+func (p *attrGlobal[T]) AutoCapitalize(value AutoCapitalize) *T {
+	addKeyword(p.el, "autocapitalize", value, AutoCapitalizeOff, AutoCapitalizeNone, AutoCapitalizeSentences, AutoCapitalizeOn, AutoCapitalizeWords, AutoCapitalizeCharacters)
+	return p.t
+}
+
+// #region G: autocorrect
+
+// AutoCorrect is an global attribute: controls whether the browser
+// automatically corrects the spelling of editable text. Without a value, or
+// when it is true, it writes autocorrect="on"; otherwise autocorrect="off".
+func (p *attrGlobal[T]) AutoCorrect(value ...bool) *T {
+	v := "on"
+	if value != nil && !value[0] {
+		v = "off"
+	}
+	p.el.addAttribute("autocorrect", v)
 	return p.t
 }
 
@@ -126,11 +347,7 @@ func (p *attrGlobal[T]) AutoCapitalize(value string) *T {
 // as the <dialog> it is part of is displayed. This attribute is a boolean,
 // initially false.
 func (p *attrGlobal[T]) AutoFocus(value ...bool) *T {
-	if value == nil {
-		p.el.addAttribute("autofocus")
-	} else {
-		p.el.addAttribute("autofocus", strconv.FormatBool(value[0]))
-	}
+	p.el.addBoolean("autofocus", value...)
 	return p.t
 }
 
@@ -142,8 +359,41 @@ func (p *attrGlobal[T]) Class(text ...string) *T {
 	return p.t
 }
 
+// RemoveClass removes the classes from the element.
+func (p *attrGlobal[T]) RemoveClass(class ...string) *T {
+	p.el.removeClasses(class...)
+	return p.t
+}
+
+// ToggleClass removes the class when the element has it and adds it
+// otherwise. With force, the class is added when it is true and removed when
+// it is false, like the DOM classList.toggle method.
+func (p *attrGlobal[T]) ToggleClass(class string, force ...bool) *T {
+	add := !p.el.hasClass(class)
+	if len(force) > 0 {
+		add = force[0]
+	}
+
+	p.el.removeClasses(class)
+	if add {
+		p.el.addClasses(class)
+	}
+	return p.t
+}
+
 // #region G: contenteditable
 
+// ContentEditable is a value of the contenteditable attribute.
+type ContentEditable string
+
+const (
+	ContentEditableTrue          ContentEditable = "true"
+	ContentEditableFalse         ContentEditable = "false"
+	ContentEditablePlaintextOnly ContentEditable = "plaintext-only"
+)
+
+var contentEditableKeywords = []ContentEditable{ContentEditableTrue, ContentEditableFalse, ContentEditablePlaintextOnly}
+
 // ContentEditable is an global attribute: indicates whether the element's
 // content is editable.
 // The contenteditable global attribute is an enumerated attribute indicating
@@ -154,19 +404,26 @@ func (p *attrGlobal[T]) Class(text ...string) *T {
 //   - true or an empty string: which indicates that the element is editable.
 //   - false: which indicates that the element is not editable.
 //   - plaintext-only: which indicates that the element's raw text is editable, but rich text formatting is disabled.
-func (p *attrGlobal[T]) ContentEditable(value ...string) *T {
+//
+// Without a value, or with an invalid one, the attribute is written without a
+// value, so the element is editable. In Strict mode, an invalid value is
+// recorded as ErrInvalidValue.
+func (p *attrGlobal[T]) ContentEditable(value ...ContentEditable) *T {
 	if value == nil {
 		p.el.addAttribute("contenteditable")
 		return p.t
 	}
 
-	var v = strings.TrimSpace(strings.ToLower(value[0]))
-	if !slices.Contains([]string{"true", "false", "plaintext-only"}, v) {
+	v := ContentEditable(strings.TrimSpace(strings.ToLower(string(value[0]))))
+	if !isKeyword(v, contentEditableKeywords) {
 		p.el.addAttribute("contenteditable")
-	} else {
-		p.el.addAttribute("contenteditable", v)
+		if Strict {
+			p.el.addError(fmt.Errorf("%w %q for attribute %q", ErrInvalidValue, value[0], "contenteditable"))
+		}
+		return p.t
 	}
 
+	p.el.addAttribute("contenteditable", string(v))
 	return p.t
 }
 
@@ -182,6 +439,18 @@ func (p *attrGlobal[T]) Data(name string, value string) *T {
 
 // #region G: dir
 
+// Dir is a text direction, the value of the dir attribute.
+type Dir string
+
+const (
+	// DirLTR is left to right, like English.
+	DirLTR Dir = "ltr"
+	// DirRTL is right to left, like Arabic.
+	DirRTL Dir = "rtl"
+	// DirAuto lets the browser decide from the content.
+	DirAuto Dir = "auto"
+)
+
 // Dir is an global attribute: defines the text direction. Allowed values
 // are ltr (Left-To-Right) or rtl (Right-To-Left).
 // The dir global attribute is an enumerated attribute that indicates the
@@ -191,8 +460,8 @@ func (p *attrGlobal[T]) Data(name string, value string) *T {
 //   - ltr: which means left to right and is to be used for languages that are written from the left to the right (like English);
 //   - rtl, which means right to left and is to be used for languages that are written from the right to the left (like Arabic);
 //   - auto: which lets the user agent decide. It uses a basic algorithm as it parses the characters inside the element until it finds a character with a strong directionality, then applies that directionality to the whole element.
-func (p *attrGlobal[T]) Dir(value string) *T {
-	p.el.addAttribute("dir", value)
+func (p *attrGlobal[T]) Dir(value Dir) *T {
+	addKeyword(p.el, "dir", value, DirLTR, DirRTL, DirAuto)
 	return p.t
 }
 
@@ -224,25 +493,50 @@ func (p *attrGlobal[T]) Draggable(value bool) *T {
 
 // #region G: enterkeyhint
 
+// EnterKeyHint is a value of the enterkeyhint attribute.
+type EnterKeyHint string
+
+const (
+	// EnterKeyHintEnter typically inserts a new line.
+	EnterKeyHintEnter EnterKeyHint = "enter"
+	// EnterKeyHintDone typically means there is nothing more to input and
+	// closes the input method editor (IME).
+	EnterKeyHintDone EnterKeyHint = "done"
+	// EnterKeyHintGo typically takes the user to the target of the text they
+	// typed.
+	EnterKeyHintGo EnterKeyHint = "go"
+	// EnterKeyHintNext typically takes the user to the next field.
+	EnterKeyHintNext EnterKeyHint = "next"
+	// EnterKeyHintPrevious typically takes the user to the previous field.
+	EnterKeyHintPrevious EnterKeyHint = "previous"
+	// EnterKeyHintSearch typically takes the user to the results of searching
+	// for the text they have typed.
+	EnterKeyHintSearch EnterKeyHint = "search"
+	// EnterKeyHintSend typically delivers the text to its target.
+	EnterKeyHintSend EnterKeyHint = "send"
+)
+
 // EnterKeyHint is an global attribute: hints what action label (or icon) to
 // present for the enter key on virtual keyboards.
 //
-// The enterkeyhint attribute is an enumerated attribute and only accepts the
-// following values:
-//   - enterkeyhint="enter": Typically inserting a new line.
-//   - enterkeyhint="done": Typically meaning there is nothing more to input and the input method editor (IME) will be closed.
-//   - enterkeyhint="go": Typically meaning to take the user to the target of the text they typed.
-//   - enterkeyhint="next": Typically taking the user to the next field that will accept text.
-//   - enterkeyhint="previous": Typically taking the user to the previous field that will accept text.
-//   - enterkeyhint="search": Typically taking the user to the results of searching for the text they have typed.
-//   - enterkeyhint="send": Typically delivering the text to its target.
-//
 // Example:
 //
-//	<input enterkeyhint="go" />
-//	<p contenteditable enterkeyhint="go">https://example.org</p>
-func (p *attrGlobal[T]) EnterKeyHint() *T {
-	p.el.addAttribute("enterkeyhint")
+//	Input().Type(InputTypeURL).EnterKeyHint(EnterKeyHintGo)
+//	// <input type="url" enterkeyhint="go"/>
+func (p *attrGlobal[T]) EnterKeyHint(value EnterKeyHint) *T {
+	addKeyword(p.el, "enterkeyhint", value, EnterKeyHintEnter, EnterKeyHintDone,
+		EnterKeyHintGo, EnterKeyHintNext, EnterKeyHintPrevious, EnterKeyHintSearch,
+		EnterKeyHintSend)
+	return p.t
+}
+
+// #region G: exportparts
+
+// ExportParts is an global attribute: exposes the parts of the shadow tree of
+// a nested element to the outer page. Each value is a part name, or a part
+// name and an alias separated by a colon, like "label:button-label".
+func (p *attrGlobal[T]) ExportParts(value ...string) *T {
+	p.el.addAttribute("exportparts", strings.Join(value, ", "))
 	return p.t
 }
 
@@ -250,8 +544,16 @@ func (p *attrGlobal[T]) EnterKeyHint() *T {
 
 // Hidden is an global attribute: is used to indicate that the content of an
 // element should not be presented to the user.
-func (p *attrGlobal[T]) Hidden() *T {
-	p.el.addAttribute("hidden")
+func (p *attrGlobal[T]) Hidden(value ...bool) *T {
+	p.el.addBoolean("hidden", value...)
+	return p.t
+}
+
+// HiddenUntilFound is an global attribute: hides the element until it is
+// found by searching in the page or by navigating to a fragment, with
+// hidden="until-found".
+func (p *attrGlobal[T]) HiddenUntilFound() *T {
+	p.el.addAttribute("hidden", "until-found")
 	return p.t
 }
 
@@ -275,16 +577,26 @@ func (p *attrGlobal[T]) Id(value string) *T {
 // inert attribute also makes the browser ignore input events sent by the user,
 // including focus-related events and events from assistive technologies.
 func (p *attrGlobal[T]) Inert(value ...bool) *T {
-	if value == nil {
-		p.el.addAttribute("inert")
-	} else {
-		p.el.addAttribute("inert", strconv.FormatBool(value[0]))
-	}
+	p.el.addBoolean("inert", value...)
 	return p.t
 }
 
 // #region G: inputmode
 
+// InputMode is a virtual keyboard, the value of the inputmode attribute.
+type InputMode string
+
+const (
+	InputModeNone    InputMode = "none"
+	InputModeText    InputMode = "text"
+	InputModeDecimal InputMode = "decimal"
+	InputModeNumeric InputMode = "numeric"
+	InputModeTel     InputMode = "tel"
+	InputModeSearch  InputMode = "search"
+	InputModeEmail   InputMode = "email"
+	InputModeURL     InputMode = "url"
+)
+
 // Inputmode is an global attribute: provides a hint to browsers about the
 // type of virtual keyboard configuration to use when editing this element or
 // its contents. Used primarily on <input> elements, but is usable on any element
@@ -299,8 +611,31 @@ func (p *attrGlobal[T]) Inert(value ...bool) *T {
 //   - search: a virtual keyboard optimized for search input. For instance, the return/submit key may be labeled "Search", along with possible other optimizations. Inputs that require a search query should typically use <input type="search"> instead.
 //   - email: a virtual keyboard optimized for entering email addresses. Typically includes the @character as well as other optimizations. Inputs that require email addresses should typically use <input type="email"> instead.
 //   - url: a keypad optimized for entering URLs. This may have the / key more prominent, for example. Enhanced features could include history access and so on. Inputs that require a URL should typically use <input type="url"> instead.
-func (p *attrGlobal[T]) Inputmode(value string) *T {
-	p.el.addAttribute("inputmode", value)
+func (p *attrGlobal[T]) Inputmode(value InputMode) *T {
+	addKeyword(p.el, "inputmode", value, InputModeNone, InputModeText, InputModeDecimal, InputModeNumeric, InputModeTel, InputModeSearch, InputModeEmail, InputModeURL)
+	return p.t
+}
+
+// #region G: is
+
+// Is is an global attribute: makes a standard element behave like the
+// customized built-in element registered with the name.
+//
+// Example:
+//
+//	Button("Copy").Is("copy-button")
+func (p *attrGlobal[T]) Is(name string) *T {
+	p.el.addAttribute("is", name)
+	return p.t
+}
+
+// #region G: itemid
+
+// ItemId is an global attribute: the unique, global identifier of a
+// microdata item, like "urn:isbn:0-330-34032-8". It requires ItemScope and
+// ItemType.
+func (p *attrGlobal[T]) ItemId(value string) *T {
+	p.el.addAttribute("itemid", value)
 	return p.t
 }
 
@@ -321,6 +656,33 @@ func (p *attrGlobal[T]) ItemProp(value string) *T {
 	return p.t
 }
 
+// #region G: itemref
+
+// ItemRef is an global attribute: the ids of the elements that hold
+// properties of the microdata item, when they are not its descendants.
+func (p *attrGlobal[T]) ItemRef(id ...string) *T {
+	p.el.addAttribute("itemref", strings.Join(id, " "))
+	return p.t
+}
+
+// #region G: itemscope
+
+// ItemScope is an global attribute: creates a microdata item, whose
+// properties are given by the ItemProp of its descendants.
+func (p *attrGlobal[T]) ItemScope(value ...bool) *T {
+	p.el.addBoolean("itemscope", value...)
+	return p.t
+}
+
+// #region G: itemtype
+
+// ItemType is an global attribute: the URLs of the vocabularies of the
+// microdata item, like "https://schema.org/Person". It requires ItemScope.
+func (p *attrGlobal[T]) ItemType(url ...string) *T {
+	p.el.addAttribute("itemtype", strings.Join(url, " "))
+	return p.t
+}
+
 // #region G: lang
 
 // Lang is an global attribute: specifies the language of the element's content.
@@ -329,6 +691,58 @@ func (p *attrGlobal[T]) Lang(value string) *T {
 	return p.t
 }
 
+// #region G: nonce
+
+// Nonce is an global attribute: the cryptographic nonce allowed by the
+// Content Security Policy to run an inline <script> or <style>. Browsers
+// hide its value from CSS selectors.
+func (p *attrGlobal[T]) Nonce(value string) *T {
+	p.el.addAttribute("nonce", value)
+	return p.t
+}
+
+// #region G: part
+
+// Part is an global attribute: the part names of an element of a shadow
+// tree, which can be styled from outside with the ::part() pseudo-element.
+func (p *attrGlobal[T]) Part(name ...string) *T {
+	p.el.addAttribute("part", strings.Join(name, " "))
+	return p.t
+}
+
+// #region G: popover
+
+// Popover is a value of the popover attribute.
+type Popover string
+
+const (
+	// PopoverAuto (default) closes the other auto popovers when shown, and is
+	// closed with a click outside it or the Escape key.
+	PopoverAuto Popover = "auto"
+	// PopoverManual is only closed explicitly.
+	PopoverManual Popover = "manual"
+	// PopoverHint only closes the other hint popovers when shown, like a
+	// tooltip.
+	PopoverHint Popover = "hint"
+)
+
+// Popover is an global attribute: makes the element a popover, hidden until
+// it is shown by a button with PopoverTarget or CommandFor, or by script.
+// Without a value, it writes the bare attribute, which is the auto state.
+//
+// Example:
+//
+//	Button("Help").PopoverTarget("help"),
+//	Div("...").Id("help").Popover(PopoverHint),
+func (p *attrGlobal[T]) Popover(value ...Popover) *T {
+	if value == nil {
+		p.el.addAttribute("popover")
+		return p.t
+	}
+	addKeyword(p.el, "popover", value[0], PopoverAuto, PopoverManual, PopoverHint)
+	return p.t
+}
+
 // #region G: role
 
 // Role is an global attribute: defines an explicit role for an element for
@@ -338,6 +752,15 @@ func (p *attrGlobal[T]) Role(value string) *T {
 	return p.t
 }
 
+// #region G: slot
+
+// Slot is an global attribute: assigns the element to the <slot> with that
+// name in the shadow tree of its parent.
+func (p *attrGlobal[T]) Slot(name string) *T {
+	p.el.addAttribute("slot", name)
+	return p.t
+}
+
 // #region G: spellcheck
 
 // SpellCheck is an global attribute: indicates whether spell checking is
@@ -352,11 +775,7 @@ func (p *attrGlobal[T]) Role(value string) *T {
 //   - empty string or true: which indicates that the element should be, if possible, checked for spelling errors.
 //   - false: which indicates that the element should not be checked for spelling errors.
 func (p *attrGlobal[T]) SpellCheck(value ...bool) *T {
-	if value == nil {
-		p.el.addAttribute("spellcheck")
-	} else {
-		p.el.addAttribute("spellcheck", strconv.FormatBool(value[0]))
-	}
+	p.el.addAttribute("spellcheck", strconv.FormatBool(value == nil || value[0]))
 	return p.t
 }
 
@@ -368,6 +787,15 @@ func (p *attrGlobal[T]) Style(text ...string) *T {
 	return p.t
 }
 
+// RemoveStyle removes the declarations of the CSS properties, like "color"
+// or "margin-top", from the element.
+func (p *attrGlobal[T]) RemoveStyle(property ...string) *T {
+	for _, prop := range property {
+		p.el.removeStyle(prop)
+	}
+	return p.t
+}
+
 // #region G: tabindex
 
 // TabIndex is an global attribute: specifies the tab order of an element.
@@ -386,6 +814,16 @@ func (p *attrGlobal[T]) Title(value string) *T {
 
 // #region G: translate
 
+// Translate is a value of the translate attribute.
+type Translate string
+
+const (
+	// TranslateYes translates the element when the page is localized.
+	TranslateYes Translate = "yes"
+	// TranslateNo leaves the element unchanged.
+	TranslateNo Translate = "no"
+)
+
 // Translate is an global attribute: specify whether an element's attribute
 // values and the values of its Text node children are to be translated when
 // the page is localized, or whether to leave them unchanged.
@@ -398,8 +836,39 @@ func (p *attrGlobal[T]) Title(value string) *T {
 // It can have the following values:
 //   - empty string or yes: which indicates that the element should be translated when the page is localized.
 //   - no: which indicates that the element must not be translated.
-func (p *attrGlobal[T]) Translate(value string) *T {
-	p.el.addAttribute("translate", value)
+func (p *attrGlobal[T]) Translate(value Translate) *T {
+	addKeyword(p.el, "translate", value, TranslateYes, TranslateNo)
+	return p.t
+}
+
+// #region G: virtualkeyboardpolicy
+
+// VirtualKeyboardPolicy is a value of the virtualkeyboardpolicy attribute.
+type VirtualKeyboardPolicy string
+
+const (
+	// VirtualKeyboardPolicyAuto (default) shows the virtual keyboard when the
+	// element is focused or tapped.
+	VirtualKeyboardPolicyAuto VirtualKeyboardPolicy = "auto"
+	// VirtualKeyboardPolicyManual leaves the virtual keyboard to script, with
+	// navigator.virtualKeyboard.
+	VirtualKeyboardPolicyManual VirtualKeyboardPolicy = "manual"
+)
+
+// VirtualKeyboardPolicy is an global attribute: controls the virtual keyboard
+// of an editable element, like one with ContentEditable.
+func (p *attrGlobal[T]) VirtualKeyboardPolicy(value VirtualKeyboardPolicy) *T {
+	addKeyword(p.el, "virtualkeyboardpolicy", value, VirtualKeyboardPolicyAuto, VirtualKeyboardPolicyManual)
+	return p.t
+}
+
+// #region G: writingsuggestions
+
+// WritingSuggestions is an global attribute: indicates whether the browser
+// may offer writing suggestions, like the end of a word or sentence, in the
+// editable element. Without a value, it writes writingsuggestions="true".
+func (p *attrGlobal[T]) WritingSuggestions(value ...bool) *T {
+	p.el.addAttribute("writingsuggestions", strconv.FormatBool(value == nil || value[0]))
 	return p.t
 }
 
@@ -461,6 +930,33 @@ func (p *attrAllow[T]) Allow(value string) *T {
 	return p.t
 }
 
+// #region allowfullscreen
+// <iframe>
+
+type attrAllowFullscreen[T any] struct {
+	attribute[T]
+}
+
+// AllowFullscreen lets the iframe use requestFullscreen(). It is the legacy
+// form of Allow("fullscreen").
+func (p *attrAllowFullscreen[T]) AllowFullscreen(value ...bool) *T {
+	p.el.addBoolean("allowfullscreen", value...)
+	return p.t
+}
+
+// #region alpha
+// <input>
+
+type attrAlpha[T any] struct {
+	attribute[T]
+}
+
+// Alpha lets a color input choose the transparency of the color.
+func (p *attrAlpha[T]) Alpha(value ...bool) *T {
+	p.el.addBoolean("alpha", value...)
+	return p.t
+}
+
 // #region alt
 // <area>, <img>, <input>
 
@@ -481,6 +977,25 @@ type attrAs[T any] struct {
 	attribute[T]
 }
 
+// As is a type of content loaded by a <link>, the value of the as attribute.
+type As string
+
+const (
+	AsAudio    As = "audio"
+	AsDocument As = "document"
+	AsEmbed    As = "embed"
+	AsFetch    As = "fetch"
+	AsFont     As = "font"
+	AsImage    As = "image"
+	AsJSON     As = "json"
+	AsObject   As = "object"
+	AsScript   As = "script"
+	AsStyle    As = "style"
+	AsTrack    As = "track"
+	AsVideo    As = "video"
+	AsWorker   As = "worker"
+)
+
 // As specifies the type of content being loaded by the link.
 // This attribute is required when rel="preload" has been set on the <link>
 // element, optional when rel="modulepreload" has been set, and otherwise should
@@ -491,8 +1006,8 @@ type attrAs[T any] struct {
 // policy, and setting of correct Accept request header.
 //
 // Furthermore, rel="preload" uses this as a signal for request prioritization.
-func (p *attrAs[T]) As(value string) *T {
-	p.el.addAttribute("as", value)
+func (p *attrAs[T]) As(value As) *T {
+	addKeyword(p.el, "as", value, AsAudio, AsDocument, AsEmbed, AsFetch, AsFont, AsImage, AsJSON, AsObject, AsScript, AsStyle, AsTrack, AsVideo, AsWorker)
 	return p.t
 }
 
@@ -504,8 +1019,8 @@ type attrAsync[T any] struct {
 }
 
 // Async specifies that the script is to be executed asynchronously.
-func (p *attrAsync[T]) Async() *T {
-	p.el.addAttribute("async")
+func (p *attrAsync[T]) Async(value ...bool) *T {
+	p.el.addBoolean("async", value...)
 	return p.t
 }
 
@@ -542,8 +1057,8 @@ type attrAutoPlay[T any] struct {
 }
 
 // AutoPlay specifies that the audio/video should start playing automatically.
-func (p *attrAutoPlay[T]) AutoPlay() *T {
-	p.el.addAttribute("autoplay")
+func (p *attrAutoPlay[T]) AutoPlay(value ...bool) *T {
+	p.el.addBoolean("autoplay", value...)
 	return p.t
 }
 
@@ -555,8 +1070,28 @@ type attrAbbr[T any] struct {
 // provided as an alternative label to use for the header cell when referencing
 // the cell in other contexts. Some user-agents, such as speech readers, may
 // present this description before the content itself.
-func (p *attrAbbr[T]) Abbr() *T {
-	p.el.addAttribute("abbr")
+func (p *attrAbbr[T]) Abbr(value string) *T {
+	p.el.addAttribute("abbr", value)
+	return p.t
+}
+
+// #region blocking
+// <link>, <script>, <style>
+
+type attrBlocking[T any] struct {
+	attribute[T]
+}
+
+// Blocking is a value of the blocking attribute.
+type Blocking string
+
+// BlockingRender blocks the rendering of the page until the resource is
+// loaded.
+const BlockingRender Blocking = "render"
+
+// Blocking specifies the operations blocked while the resource is fetched.
+func (p *attrBlocking[T]) Blocking(value ...Blocking) *T {
+	addKeywords(p.el, "blocking", value, BlockingRender)
 	return p.t
 }
 
@@ -567,9 +1102,19 @@ type attrCapture[T any] struct {
 	attribute[T]
 }
 
+// Capture is a camera, the value of the capture attribute.
+type Capture string
+
+const (
+	// CaptureUser is the camera facing the user.
+	CaptureUser Capture = "user"
+	// CaptureEnvironment is the camera facing away from the user.
+	CaptureEnvironment Capture = "environment"
+)
+
 // Capture media capture input method in file upload controls.
-func (p *attrCapture[T]) Capture(value string) *T {
-	p.el.addAttribute("capture", value)
+func (p *attrCapture[T]) Capture(value Capture) *T {
+	addKeyword(p.el, "capture", value, CaptureUser, CaptureEnvironment)
 	return p.t
 }
 
@@ -596,8 +1141,8 @@ type attrChecked[T any] struct {
 }
 
 // Checked specifies that an input element should be pre-selected.
-func (p *attrChecked[T]) Checked() *T {
-	p.el.addAttribute("checked")
+func (p *attrChecked[T]) Checked(value ...bool) *T {
+	p.el.addBoolean("checked", value...)
 	return p.t
 }
 
@@ -614,6 +1159,67 @@ func (p *attrCite[T]) Cite(value string) *T {
 	return p.t
 }
 
+// #region closedby
+// <dialog>
+
+type attrClosedBy[T any] struct {
+	attribute[T]
+}
+
+// ClosedBy is a value of the closedby attribute.
+type ClosedBy string
+
+const (
+	// ClosedByAny closes the dialog with a click outside it, the Escape key
+	// or script.
+	ClosedByAny ClosedBy = "any"
+	// ClosedByCloseRequest closes the dialog with the Escape key or script.
+	ClosedByCloseRequest ClosedBy = "closerequest"
+	// ClosedByNone only closes the dialog from script.
+	ClosedByNone ClosedBy = "none"
+)
+
+// ClosedBy specifies the user actions that close the dialog.
+func (p *attrClosedBy[T]) ClosedBy(value ClosedBy) *T {
+	addKeyword(p.el, "closedby", value, ClosedByAny, ClosedByCloseRequest, ClosedByNone)
+	return p.t
+}
+
+// #region color
+// <link>
+
+type attrColor[T any] struct {
+	attribute[T]
+}
+
+// Color is the color used to customize a mask icon (rel="mask-icon").
+func (p *attrColor[T]) Color(value string) *T {
+	p.el.addAttribute("color", value)
+	return p.t
+}
+
+// #region colorspace
+// <input>
+
+type attrColorSpace[T any] struct {
+	attribute[T]
+}
+
+// ColorSpace is a value of the colorspace attribute.
+type ColorSpace string
+
+const (
+	// ColorSpaceLimitedSRGB (default).
+	ColorSpaceLimitedSRGB ColorSpace = "limited-srgb"
+	ColorSpaceDisplayP3   ColorSpace = "display-p3"
+)
+
+// ColorSpace specifies the color space of the value of a color input.
+func (p *attrColorSpace[T]) ColorSpace(value ColorSpace) *T {
+	addKeyword(p.el, "colorspace", value, ColorSpaceLimitedSRGB, ColorSpaceDisplayP3)
+	return p.t
+}
+
 // #region cols
 // <textarea>
 
@@ -640,6 +1246,55 @@ func (p *attrColSpan[T]) ColSpan(value int) *T {
 	return p.t
 }
 
+// #region command
+// <button>
+
+type attrCommand[T any] struct {
+	attribute[T]
+}
+
+// Command is a value of the command attribute. Custom commands start with
+// two dashes, like "--rotate".
+type Command string
+
+const (
+	CommandTogglePopover Command = "toggle-popover"
+	CommandShowPopover   Command = "show-popover"
+	CommandHidePopover   Command = "hide-popover"
+	CommandShowModal     Command = "show-modal"
+	CommandClose         Command = "close"
+	CommandRequestClose  Command = "request-close"
+)
+
+// Command specifies the action performed on the element given by CommandFor
+// when the button is clicked.
+//
+// Example:
+//
+//	Button("Open").CommandFor("dialog").Command(CommandShowModal)
+func (p *attrCommand[T]) Command(value Command) *T {
+	if strings.HasPrefix(string(value), "--") {
+		p.el.addAttribute("command", string(value))
+		return p.t
+	}
+	addKeyword(p.el, "command", value, CommandTogglePopover, CommandShowPopover,
+		CommandHidePopover, CommandShowModal, CommandClose, CommandRequestClose)
+	return p.t
+}
+
+// #region commandfor
+// <button>
+
+type attrCommandFor[T any] struct {
+	attribute[T]
+}
+
+// CommandFor takes the ID of the element controlled by the button.
+func (p *attrCommandFor[T]) CommandFor(value string) *T {
+	p.el.addAttribute("commandfor", value)
+	return p.t
+}
+
 // #region content
 // <meta>
 
@@ -662,8 +1317,8 @@ type attrControls[T any] struct {
 }
 
 // Controls specifies that audio/video controls should be displayed.
-func (p *attrControls[T]) Controls() *T {
-	p.el.addAttribute("controls")
+func (p *attrControls[T]) Controls(value ...bool) *T {
+	p.el.addBoolean("controls", value...)
 	return p.t
 }
 
@@ -674,15 +1329,25 @@ type attrControlsList[T any] struct {
 	attribute[T]
 }
 
+// ControlsList is a control hidden by the controlslist attribute.
+type ControlsList string
+
+const (
+	ControlsListNoDownload       ControlsList = "nodownload"
+	ControlsListNoFullscreen     ControlsList = "nofullscreen"
+	ControlsListNoRemotePlayback ControlsList = "noremoteplayback"
+)
+
 // ControlsList when specified, helps the browser select what controls to show
 // for the video element whenever the browser shows its own set of controls
 // (that is, when the controls attribute is specified).
 //
-// The allowed values are nodownload, nofullscreen and noremoteplayback.
+// The allowed values are nodownload, nofullscreen and noremoteplayback; the
+// values are separated by spaces.
 // Use the disablepictureinpicture attribute if you want to disable the
 // Picture-In-Picture mode (and the control).
-func (p *attrControlsList[T]) ControlsList() *T {
-	p.el.addAttribute("controlslist")
+func (p *attrControlsList[T]) ControlsList(value ...ControlsList) *T {
+	addKeywords(p.el, "controlslist", value, ControlsListNoDownload, ControlsListNoFullscreen, ControlsListNoRemotePlayback)
 	return p.t
 }
 
@@ -708,9 +1373,19 @@ type attrCrossOrigin[T any] struct {
 	attribute[T]
 }
 
+// CrossOrigin is a CORS mode, the value of the crossorigin attribute.
+type CrossOrigin string
+
+const (
+	// CrossOriginAnonymous sends credentials only to the same origin.
+	CrossOriginAnonymous CrossOrigin = "anonymous"
+	// CrossOriginUseCredentials always sends credentials.
+	CrossOriginUseCredentials CrossOrigin = "use-credentials"
+)
+
 // CrossOrigin how the element handles cross-origin requests.
-func (p *attrCrossOrigin[T]) CrossOrigin(value string) *T {
-	p.el.addAttribute("crossorigin", value)
+func (p *attrCrossOrigin[T]) CrossOrigin(value CrossOrigin) *T {
+	addKeyword(p.el, "crossorigin", value, CrossOriginAnonymous, CrossOriginUseCredentials)
 	return p.t
 }
 
@@ -747,9 +1422,21 @@ type attrDecoding[T any] struct {
 	attribute[T]
 }
 
+// Decoding is a value of the decoding attribute.
+type Decoding string
+
+const (
+	// DecodingSync decodes the image synchronously.
+	DecodingSync Decoding = "sync"
+	// DecodingAsync decodes the image asynchronously.
+	DecodingAsync Decoding = "async"
+	// DecodingAuto lets the browser decide (default).
+	DecodingAuto Decoding = "auto"
+)
+
 // Decoding indicates the preferred method to decode the image.
-func (p *attrDecoding[T]) Decoding(value string) *T {
-	p.el.addAttribute("decoding", value)
+func (p *attrDecoding[T]) Decoding(value Decoding) *T {
+	addKeyword(p.el, "decoding", value, DecodingSync, DecodingAsync, DecodingAuto)
 	return p.t
 }
 
@@ -762,8 +1449,8 @@ type attrDefault[T any] struct {
 
 // Default indicates that the track should be enabled unless the user's
 // preferences indicate something different.
-func (p *attrDefault[T]) Default() *T {
-	p.el.addAttribute("default")
+func (p *attrDefault[T]) Default(value ...bool) *T {
+	p.el.addBoolean("default", value...)
 	return p.t
 }
 
@@ -776,8 +1463,8 @@ type attrDefer[T any] struct {
 
 // Defer indicates that the script should be executed after the page has been
 // parsed.
-func (p *attrDefer[T]) Defer() *T {
-	p.el.addAttribute("defer")
+func (p *attrDefer[T]) Defer(value ...bool) *T {
+	p.el.addBoolean("defer", value...)
 	return p.t
 }
 
@@ -810,8 +1497,8 @@ type attrDisabled[T any] struct {
 }
 
 // Disabled specifies that an element should be disabled.
-func (p *attrDisabled[T]) Disabled() *T {
-	p.el.addAttribute("disabled")
+func (p *attrDisabled[T]) Disabled(value ...bool) *T {
+	p.el.addBoolean("disabled", value...)
 	return p.t
 }
 
@@ -825,8 +1512,8 @@ type attrDisablePictureInPicture[T any] struct {
 // DisablePictureInPicture prevents the browser from suggesting a
 // Picture-in-Picture context menu or to request Picture-in-Picture
 // automatically in some cases.
-func (p *attrDisablePictureInPicture[T]) DisablePictureInPicture() *T {
-	p.el.addAttribute("disablepictureinpicture")
+func (p *attrDisablePictureInPicture[T]) DisablePictureInPicture(value ...bool) *T {
+	p.el.addBoolean("disablepictureinpicture", value...)
 	return p.t
 }
 
@@ -841,8 +1528,8 @@ type attrDisableRemotePlayBack[T any] struct {
 // capability of remote playback in devices that are attached using wired
 // (HDMI, DVI, etc.) and wireless technologies (Miracast, Chromecast, DLNA,
 // AirPlay, etc.).
-func (p *attrDisableRemotePlayBack[T]) DisableRemotePlayback(value bool) *T {
-	p.el.addAttribute("disableremoteplayback", value)
+func (p *attrDisableRemotePlayBack[T]) DisableRemotePlayback(value ...bool) *T {
+	p.el.addBoolean("disableremoteplayback", value...)
 	return p.t
 }
 
@@ -867,6 +1554,19 @@ type attrEncType[T any] struct {
 	attribute[T]
 }
 
+// EncType is a MIME type used to submit a form, the value of the enctype and
+// formenctype attributes.
+type EncType string
+
+const (
+	// EncTypeURLEncoded (default).
+	EncTypeURLEncoded EncType = "application/x-www-form-urlencoded"
+	// EncTypeMultipart is required to upload files.
+	EncTypeMultipart EncType = "multipart/form-data"
+	// EncTypePlain is only useful for debugging.
+	EncTypePlain EncType = "text/plain"
+)
+
 // EncType specifies how the form data should be encoded when submitting to the
 // server. Defines the content type of the form data when the method is POST.
 //
@@ -879,8 +1579,32 @@ type attrEncType[T any] struct {
 //   - text/plain: useful for debugging purposes.
 //
 // This value can be overridden by formenctype attributes on <button>, <input type="submit">, or <input type="image"> elements.
-func (p *attrEncType[T]) EncType(value string) *T {
-	p.el.addAttribute("enctype", value)
+func (p *attrEncType[T]) EncType(value EncType) *T {
+	addKeyword(p.el, "enctype", value, EncTypeURLEncoded, EncTypeMultipart, EncTypePlain)
+	return p.t
+}
+
+// #region fetchpriority
+// <img>, <link>, <script>
+
+type attrFetchPriority[T any] struct {
+	attribute[T]
+}
+
+// FetchPriority is a value of the fetchpriority attribute.
+type FetchPriority string
+
+const (
+	FetchPriorityHigh FetchPriority = "high"
+	FetchPriorityLow  FetchPriority = "low"
+	// FetchPriorityAuto (default).
+	FetchPriorityAuto FetchPriority = "auto"
+)
+
+// FetchPriority gives a hint of the priority of the resource compared to the
+// other resources of the page.
+func (p *attrFetchPriority[T]) FetchPriority(value FetchPriority) *T {
+	addKeyword(p.el, "fetchpriority", value, FetchPriorityHigh, FetchPriorityLow, FetchPriorityAuto)
 	return p.t
 }
 
@@ -938,8 +1662,8 @@ type attrFormEncType[T any] struct {
 //
 // If this attribute is specified, it overrides the enctype attribute of the
 // button's form owner.
-func (p *attrFormEncType[T]) FormEncType(value string) *T {
-	p.el.addAttribute("formenctype", value)
+func (p *attrFormEncType[T]) FormEncType(value EncType) *T {
+	addKeyword(p.el, "formenctype", value, EncTypeURLEncoded, EncTypeMultipart, EncTypePlain)
 	return p.t
 }
 
@@ -956,8 +1680,8 @@ type attrFormMethod[T any] struct {
 //
 // If this attribute is specified, it overrides the method attribute of the
 // button's form owner.
-func (p *attrFormMethod[T]) FormMethod(value string) *T {
-	p.el.addAttribute("formmethod", value)
+func (p *attrFormMethod[T]) FormMethod(value Method) *T {
+	addKeyword(p.el, "formmethod", value, MethodGet, MethodPost, MethodDialog)
 	return p.t
 }
 
@@ -977,8 +1701,8 @@ type attrFormNoValidate[T any] struct {
 //
 // This attribute is also available on <input type="image"> and
 // <input type="submit"> elements.
-func (p *attrFormNoValidate[T]) FormNoValidate() *T {
-	p.el.addAttribute("formnovalidate")
+func (p *attrFormNoValidate[T]) FormNoValidate(value ...bool) *T {
+	p.el.addBoolean("formnovalidate", value...)
 	return p.t
 }
 
@@ -996,8 +1720,8 @@ type attrFormTarget[T any] struct {
 //
 // If this attribute is specified, it overrides the target attribute of the
 // button's form owner.
-func (p *attrFormTarget[T]) FormTarget(value string) *T {
-	p.el.addAttribute("formtarget", value)
+func (p *attrFormTarget[T]) FormTarget(value Target) *T {
+	addTarget(p.el, "formtarget", value)
 	return p.t
 }
 
@@ -1016,6 +1740,20 @@ func (p *attrHeaders[T]) Headers(value string) *T {
 	return p.t
 }
 
+// #region height
+// <canvas>, <embed>, <fencedframe>, <iframe>, <img>, <input>, <object>,
+// <source>, <video>
+
+type attrHeight[T any] struct {
+	attribute[T]
+}
+
+// Height specifies the height of the element in CSS pixels.
+func (p *attrHeight[T]) Height(value int) *T {
+	p.el.addAttribute("height", value)
+	return p.t
+}
+
 // #region high
 // <meter>
 
@@ -1063,10 +1801,49 @@ type attrHttpEquiv[T any] struct {
 	attribute[T]
 }
 
+// HttpEquiv is a pragma directive, the value of the http-equiv attribute.
+type HttpEquiv string
+
+const (
+	HttpEquivContentSecurityPolicy HttpEquiv = "content-security-policy"
+	HttpEquivContentType           HttpEquiv = "content-type"
+	HttpEquivDefaultStyle          HttpEquiv = "default-style"
+	HttpEquivRefresh               HttpEquiv = "refresh"
+	HttpEquivXUACompatible         HttpEquiv = "x-ua-compatible"
+)
+
 // HttpEquiv provides an HTTP header for the value of the content attribute.
 // Defines a pragma directive.
-func (p *attrHttpEquiv[T]) HttpEquiv(value string) *T {
-	p.el.addAttribute("http-equiv", value)
+func (p *attrHttpEquiv[T]) HttpEquiv(value HttpEquiv) *T {
+	addKeyword(p.el, "http-equiv", value, HttpEquivContentSecurityPolicy, HttpEquivContentType, HttpEquivDefaultStyle, HttpEquivRefresh, HttpEquivXUACompatible)
+	return p.t
+}
+
+// #region imagesizes
+// <link>
+
+type attrImageSizes[T any] struct {
+	attribute[T]
+}
+
+// ImageSizes is the sizes attribute of the preloaded image, with
+// rel="preload" and as="image".
+func (p *attrImageSizes[T]) ImageSizes(value string) *T {
+	p.el.addAttribute("imagesizes", value)
+	return p.t
+}
+
+// #region imagesrcset
+// <link>
+
+type attrImageSrcSet[T any] struct {
+	attribute[T]
+}
+
+// ImageSrcSet is the srcset attribute of the preloaded image, with
+// rel="preload" and as="image".
+func (p *attrImageSrcSet[T]) ImageSrcSet(value string) *T {
+	p.el.addAttribute("imagesrcset", value)
 	return p.t
 }
 
@@ -1092,8 +1869,8 @@ type attrIsmap[T any] struct {
 }
 
 // Ismap specifies that an image is part of a client-side image map.
-func (p *attrIsmap[T]) Ismap() *T {
-	p.el.addAttribute("ismap")
+func (p *attrIsmap[T]) Ismap(value ...bool) *T {
+	p.el.addBoolean("ismap", value...)
 	return p.t
 }
 
@@ -1104,6 +1881,17 @@ type attrKind[T any] struct {
 	attribute[T]
 }
 
+// Kind is a type of text track, the value of the kind attribute.
+type Kind string
+
+const (
+	KindSubtitles    Kind = "subtitles"
+	KindCaptions     Kind = "captions"
+	KindDescriptions Kind = "descriptions"
+	KindChapters     Kind = "chapters"
+	KindMetadata     Kind = "metadata"
+)
+
 // Kind represents how the text track is meant to be used. If omitted the
 // default kind is subtitles. If the attribute contains an invalid value, it
 // will use metadata.
@@ -1113,8 +1901,8 @@ type attrKind[T any] struct {
 //   - captions: closed captions provide a transcription and possibly a translation of audio. It may include important non-verbal information such as music cues or sound effects. It may indicate the cue's source (e.g. music, text, character). Suitable for users who are deaf or when the sound is muted.
 //   - chapters: chapter titles are intended to be used when the user is navigating the media resource.
 //   - metadata: tracks used by scripts. Not visible to the user.
-func (p *attrKind[T]) Kind(value string) *T {
-	p.el.addAttribute("kind", value)
+func (p *attrKind[T]) Kind(value Kind) *T {
+	addKeyword(p.el, "kind", value, KindSubtitles, KindCaptions, KindDescriptions, KindChapters, KindMetadata)
 	return p.t
 }
 
@@ -1138,10 +1926,20 @@ type attrLoading[T any] struct {
 	attribute[T]
 }
 
+// Loading is a value of the loading attribute.
+type Loading string
+
+const (
+	// LoadingEager loads immediately (default).
+	LoadingEager Loading = "eager"
+	// LoadingLazy defers loading until the element is near the viewport.
+	LoadingLazy Loading = "lazy"
+)
+
 // Loading indicates if the element should be loaded lazily (loading="lazy")
 // or loaded immediately (loading="eager").
-func (p *attrLoading[T]) Loading(value string) *T {
-	p.el.addAttribute("loading", value)
+func (p *attrLoading[T]) Loading(value Loading) *T {
+	addKeyword(p.el, "loading", value, LoadingEager, LoadingLazy)
 	return p.t
 }
 
@@ -1166,8 +1964,8 @@ type attrLoop[T any] struct {
 }
 
 // Loop specifies that the media should start over again when it reaches the end.
-func (p *attrLoop[T]) Loop() *T {
-	p.el.addAttribute("loop")
+func (p *attrLoop[T]) Loop(value ...bool) *T {
+	p.el.addBoolean("loop", value...)
 	return p.t
 }
 
@@ -1258,6 +2056,17 @@ type attrMethod[T any] struct {
 	attribute[T]
 }
 
+// Method is an HTTP method used to submit a form, the value of the method and
+// formmethod attributes.
+type Method string
+
+const (
+	MethodGet  Method = "get"
+	MethodPost Method = "post"
+	// MethodDialog closes the dialog the form is in, without submitting it.
+	MethodDialog Method = "dialog"
+)
+
 // Method specifies the HTTP method to use when submitting form data.
 // The HTTP method to submit the form with.
 //
@@ -1267,8 +2076,8 @@ type attrMethod[T any] struct {
 //   - dialog: when the form is inside a <dialog>, closes the dialog and causes a submit event to be fired on submission, without submitting data or clearing the form.
 //
 // This value is overridden by formmethod attributes on <button>, <input type="submit">, or <input type="image"> elements.
-func (p *attrMethod[T]) Method(value string) *T {
-	p.el.addAttribute("method", value)
+func (p *attrMethod[T]) Method(value Method) *T {
+	addKeyword(p.el, "method", value, MethodGet, MethodPost, MethodDialog)
 	return p.t
 }
 
@@ -1295,8 +2104,8 @@ type attrMultiple[T any] struct {
 }
 
 // Multiple specifies that multiple options can be selected.
-func (p *attrMultiple[T]) Multiple() *T {
-	p.el.addAttribute("multiple")
+func (p *attrMultiple[T]) Multiple(value ...bool) *T {
+	p.el.addBoolean("multiple", value...)
 	return p.t
 }
 
@@ -1308,8 +2117,8 @@ type attrMuted[T any] struct {
 }
 
 // Muted specifies that the audio/video should be muted.
-func (p *attrMuted[T]) Muted() *T {
-	p.el.addAttribute("muted")
+func (p *attrMuted[T]) Muted(value ...bool) *T {
+	p.el.addBoolean("muted", value...)
 	return p.t
 }
 
@@ -1327,6 +2136,20 @@ func (p *attrName[T]) Name(value string) *T {
 	return p.t
 }
 
+// #region nomodule
+// <script>
+
+type attrNoModule[T any] struct {
+	attribute[T]
+}
+
+// NoModule prevents the script from running in browsers that support
+// modules. It provides a fallback for older browsers.
+func (p *attrNoModule[T]) NoModule(value ...bool) *T {
+	p.el.addBoolean("nomodule", value...)
+	return p.t
+}
+
 // #region novalidate
 // <form>
 
@@ -1335,8 +2158,8 @@ type attrNoValidate[T any] struct {
 }
 
 // NoValidate indicates that the form shouldn't be validated when submitted.
-func (p *attrNoValidate[T]) NoValidate() *T {
-	p.el.addAttribute("novalidate")
+func (p *attrNoValidate[T]) NoValidate(value ...bool) *T {
+	p.el.addBoolean("novalidate", value...)
 	return p.t
 }
 
@@ -1351,12 +2174,7 @@ type attrOpen[T any] struct {
 // a <details> element) or whether the dialog is active and can be interacted
 // with (in the case of a <dialog> element).
 func (p *attrOpen[T]) Open(value ...bool) *T {
-	if value == nil {
-		p.el.addAttribute("open")
-	} else {
-		p.el.addAttribute("open", strconv.FormatBool(value[0]))
-	}
-
+	p.el.addBoolean("open", value...)
 	return p.t
 }
 
@@ -1440,8 +2258,8 @@ type attrPlaysInLine[T any] struct {
 // PlaysInLine indicating that the video is to be played "inline"; that is,
 // within the element's playback area. Note that the absence of this attribute
 // does not imply that the video will always be played in fullscreen.
-func (p *attrPlaysInLine[T]) PlaysInLine(value bool) *T {
-	p.el.addAttribute("playsinline", value)
+func (p *attrPlaysInLine[T]) PlaysInLine(value ...bool) *T {
+	p.el.addBoolean("playsinline", value...)
 	return p.t
 }
 
@@ -1466,9 +2284,21 @@ type attrPreLoad[T any] struct {
 	attribute[T]
 }
 
+// PreLoad is a value of the preload attribute.
+type PreLoad string
+
+const (
+	// PreLoadNone does not preload the media.
+	PreLoadNone PreLoad = "none"
+	// PreLoadMetadata only fetches the metadata, like the length.
+	PreLoadMetadata PreLoad = "metadata"
+	// PreLoadAuto may download the whole file.
+	PreLoadAuto PreLoad = "auto"
+)
+
 // PreLoad specifies if and how the media file should be loaded when the page loads.
-func (p *attrPreLoad[T]) PreLoad(value string) *T {
-	p.el.addAttribute("preload", value)
+func (p *attrPreLoad[T]) PreLoad(value PreLoad) *T {
+	addKeyword(p.el, "preload", value, PreLoadNone, PreLoadMetadata, PreLoadAuto)
 	return p.t
 }
 
@@ -1497,6 +2327,16 @@ type attrPopoverTargetAction[T any] struct {
 	attribute[T]
 }
 
+// PopoverTargetAction is a value of the popovertargetaction attribute.
+type PopoverTargetAction string
+
+const (
+	PopoverTargetHide PopoverTargetAction = "hide"
+	PopoverTargetShow PopoverTargetAction = "show"
+	// PopoverTargetToggle (default).
+	PopoverTargetToggle PopoverTargetAction = "toggle"
+)
+
 // PopoverTargetAction specifies the action to be performed on a popover element.
 //
 // Possible values are:
@@ -1505,8 +2345,8 @@ type attrPopoverTargetAction[T any] struct {
 //   - "toggle": the button will toggle a popover between showing and hidden. If the popover is hidden, it will be shown; if the popover is showing, it will be hidden. If popovertargetaction is omitted, "toggle" is the default action that will be performed by the control button.
 //
 // [Popover API landing page]: https://developer.mozilla.org/en-US/docs/Web/API/Popover_API
-func (p *attrPopoverTargetAction[T]) PopoverTargetAction(value string) *T {
-	p.el.addAttribute("popovertargetaction", value)
+func (p *attrPopoverTargetAction[T]) PopoverTargetAction(value PopoverTargetAction) *T {
+	addKeyword(p.el, "popovertargetaction", value, PopoverTargetHide, PopoverTargetShow, PopoverTargetToggle)
 	return p.t
 }
 
@@ -1518,8 +2358,41 @@ type attrReadOnly[T any] struct {
 }
 
 // ReadOnly specifies that an input field is read-only.
-func (p *attrReadOnly[T]) ReadOnly() *T {
-	p.el.addAttribute("readonly")
+func (p *attrReadOnly[T]) ReadOnly(value ...bool) *T {
+	p.el.addBoolean("readonly", value...)
+	return p.t
+}
+
+// #region referrerpolicy
+// <a>, <area>, <iframe>, <img>, <link>, <script>
+
+type attrReferrerPolicy[T any] struct {
+	attribute[T]
+}
+
+// ReferrerPolicy is a value of the referrerpolicy attribute.
+type ReferrerPolicy string
+
+const (
+	ReferrerPolicyNoReferrer              ReferrerPolicy = "no-referrer"
+	ReferrerPolicyNoReferrerWhenDowngrade ReferrerPolicy = "no-referrer-when-downgrade"
+	ReferrerPolicyOrigin                  ReferrerPolicy = "origin"
+	ReferrerPolicyOriginWhenCrossOrigin   ReferrerPolicy = "origin-when-cross-origin"
+	ReferrerPolicySameOrigin              ReferrerPolicy = "same-origin"
+	ReferrerPolicyStrictOrigin            ReferrerPolicy = "strict-origin"
+	// ReferrerPolicyStrictOriginWhenCrossOrigin (default).
+	ReferrerPolicyStrictOriginWhenCrossOrigin ReferrerPolicy = "strict-origin-when-cross-origin"
+	ReferrerPolicyUnsafeURL                   ReferrerPolicy = "unsafe-url"
+)
+
+// ReferrerPolicy specifies the referrer sent when fetching the resource or
+// following the link.
+func (p *attrReferrerPolicy[T]) ReferrerPolicy(value ReferrerPolicy) *T {
+	addKeyword(p.el, "referrerpolicy", value, ReferrerPolicyNoReferrer,
+		ReferrerPolicyNoReferrerWhenDowngrade, ReferrerPolicyOrigin,
+		ReferrerPolicyOriginWhenCrossOrigin, ReferrerPolicySameOrigin,
+		ReferrerPolicyStrictOrigin, ReferrerPolicyStrictOriginWhenCrossOrigin,
+		ReferrerPolicyUnsafeURL)
 	return p.t
 }
 
@@ -1530,10 +2403,51 @@ type attrRel[T any] struct {
 	attribute[T]
 }
 
+// Rel is a link type, a value of the rel attribute.
+type Rel string
+
+const (
+	RelAlternate      Rel = "alternate"
+	RelAuthor         Rel = "author"
+	RelBookmark       Rel = "bookmark"
+	RelCanonical      Rel = "canonical"
+	RelDNSPrefetch    Rel = "dns-prefetch"
+	RelExpect         Rel = "expect"
+	RelExternal       Rel = "external"
+	RelHelp           Rel = "help"
+	RelIcon           Rel = "icon"
+	RelLicense        Rel = "license"
+	RelManifest       Rel = "manifest"
+	RelMe             Rel = "me"
+	RelModulePreload  Rel = "modulepreload"
+	RelNext           Rel = "next"
+	RelNoFollow       Rel = "nofollow"
+	RelNoOpener       Rel = "noopener"
+	RelNoReferrer     Rel = "noreferrer"
+	RelOpener         Rel = "opener"
+	RelPingback       Rel = "pingback"
+	RelPreconnect     Rel = "preconnect"
+	RelPrefetch       Rel = "prefetch"
+	RelPreload        Rel = "preload"
+	RelPrev           Rel = "prev"
+	RelPrivacyPolicy  Rel = "privacy-policy"
+	RelSearch         Rel = "search"
+	RelShortcut       Rel = "shortcut" // legacy, only in "shortcut icon"
+	RelSponsored      Rel = "sponsored"
+	RelStylesheet     Rel = "stylesheet"
+	RelTag            Rel = "tag"
+	RelTermsOfService Rel = "terms-of-service"
+	RelUGC            Rel = "ugc"
+)
+
 // Rel specifies the relationship between the current document and the linked
-// document.
-func (p *attrRel[T]) Rel(value string) *T {
-	p.el.addAttribute("rel", value)
+// document. Several link types are separated by spaces.
+//
+// Example:
+//
+//	A("docs").Href(url).Target(TargetBlank).Rel(RelNoOpener, RelNoReferrer)
+func (p *attrRel[T]) Rel(value ...Rel) *T {
+	addKeywords(p.el, "rel", value, RelAlternate, RelAuthor, RelBookmark, RelCanonical, RelDNSPrefetch, RelExpect, RelExternal, RelHelp, RelIcon, RelLicense, RelManifest, RelMe, RelModulePreload, RelNext, RelNoFollow, RelNoOpener, RelNoReferrer, RelOpener, RelPingback, RelPreconnect, RelPrefetch, RelPreload, RelPrev, RelPrivacyPolicy, RelSearch, RelShortcut, RelSponsored, RelStylesheet, RelTag, RelTermsOfService, RelUGC)
 	return p.t
 }
 
@@ -1546,8 +2460,8 @@ type attrRequired[T any] struct {
 
 // Required specifies that an input field must be filled out before submitting
 // the form.
-func (p *attrRequired[T]) Required() *T {
-	p.el.addAttribute("required")
+func (p *attrRequired[T]) Required(value ...bool) *T {
+	p.el.addBoolean("required", value...)
 	return p.t
 }
 
@@ -1560,8 +2474,8 @@ type attrReversed[T any] struct {
 
 // Reversed indicates whether the list should be displayed in a descending order
 // instead of an ascending order.
-func (p *attrReversed[T]) Reversed() *T {
-	p.el.addAttribute("reversed")
+func (p *attrReversed[T]) Reversed(value ...bool) *T {
+	p.el.addBoolean("reversed", value...)
 	return p.t
 }
 
@@ -1598,9 +2512,30 @@ type attrSandbox[T any] struct {
 	attribute[T]
 }
 
+// Sandbox is a restriction lifted by the sandbox attribute.
+type Sandbox string
+
+const (
+	SandboxAllowDownloads                      Sandbox = "allow-downloads"
+	SandboxAllowForms                          Sandbox = "allow-forms"
+	SandboxAllowModals                         Sandbox = "allow-modals"
+	SandboxAllowOrientationLock                Sandbox = "allow-orientation-lock"
+	SandboxAllowPointerLock                    Sandbox = "allow-pointer-lock"
+	SandboxAllowPopups                         Sandbox = "allow-popups"
+	SandboxAllowPopupsToEscapeSandbox          Sandbox = "allow-popups-to-escape-sandbox"
+	SandboxAllowPresentation                   Sandbox = "allow-presentation"
+	SandboxAllowSameOrigin                     Sandbox = "allow-same-origin"
+	SandboxAllowScripts                        Sandbox = "allow-scripts"
+	SandboxAllowStorageAccessByUserActivation  Sandbox = "allow-storage-access-by-user-activation"
+	SandboxAllowTopNavigation                  Sandbox = "allow-top-navigation"
+	SandboxAllowTopNavigationByUserActivation  Sandbox = "allow-top-navigation-by-user-activation"
+	SandboxAllowTopNavigationToCustomProtocols Sandbox = "allow-top-navigation-to-custom-protocols"
+)
+
 // Sandbox enables an extra set of restrictions for the content in an iframe.
-func (p *attrSandbox[T]) Sandbox(value string) *T {
-	p.el.addAttribute("sandbox", value)
+// Without values, all the restrictions apply; each value lifts one of them.
+func (p *attrSandbox[T]) Sandbox(value ...Sandbox) *T {
+	addKeywords(p.el, "sandbox", value, SandboxAllowDownloads, SandboxAllowForms, SandboxAllowModals, SandboxAllowOrientationLock, SandboxAllowPointerLock, SandboxAllowPopups, SandboxAllowPopupsToEscapeSandbox, SandboxAllowPresentation, SandboxAllowSameOrigin, SandboxAllowScripts, SandboxAllowStorageAccessByUserActivation, SandboxAllowTopNavigation, SandboxAllowTopNavigationByUserActivation, SandboxAllowTopNavigationToCustomProtocols)
 	return p.t
 }
 
@@ -1611,6 +2546,16 @@ type attrScope[T any] struct {
 	attribute[T]
 }
 
+// Scope is a set of cells of a header, the value of the scope attribute.
+type Scope string
+
+const (
+	ScopeRow      Scope = "row"
+	ScopeCol      Scope = "col"
+	ScopeRowGroup Scope = "rowgroup"
+	ScopeColGroup Scope = "colgroup"
+)
+
 // Scope specifies whether a header cell is a header for a row, column, or group
 // of rows or columns.
 //
@@ -1623,8 +2568,8 @@ type attrScope[T any] struct {
 //   - colgroup: the header belongs to a colgroup and relates to all of its cells.
 //
 // If the scope attribute is not specified, or its value is not row, col, rowgroup, or colgroup, then browsers automatically select the set of cells to which the header cell applies.
-func (p *attrScope[T]) Scope(value string) *T {
-	p.el.addAttribute("scope", value)
+func (p *attrScope[T]) Scope(value Scope) *T {
+	addKeyword(p.el, "scope", value, ScopeRow, ScopeCol, ScopeRowGroup, ScopeColGroup)
 	return p.t
 }
 
@@ -1636,8 +2581,59 @@ type attrSelected[T any] struct {
 }
 
 // Selected specifies that an option should be pre-selected when the page loads.
-func (p *attrSelected[T]) Selected() *T {
-	p.el.addAttribute("selected")
+func (p *attrSelected[T]) Selected(value ...bool) *T {
+	p.el.addBoolean("selected", value...)
+	return p.t
+}
+
+// #region shadowroot*
+// <template>
+
+type attrShadowRoot[T any] struct {
+	attribute[T]
+}
+
+// ShadowRootMode is a value of the shadowrootmode attribute.
+type ShadowRootMode string
+
+const (
+	// ShadowRootOpen exposes the shadow root to script with element.shadowRoot.
+	ShadowRootOpen ShadowRootMode = "open"
+	// ShadowRootClosed hides the shadow root from script.
+	ShadowRootClosed ShadowRootMode = "closed"
+)
+
+// ShadowRootMode makes the template a declarative shadow root: the parser
+// attaches its content as the shadow root of the parent element.
+func (p *attrShadowRoot[T]) ShadowRootMode(value ShadowRootMode) *T {
+	addKeyword(p.el, "shadowrootmode", value, ShadowRootOpen, ShadowRootClosed)
+	return p.t
+}
+
+// ShadowRootDelegatesFocus gives the focus to the first focusable element of
+// the shadow root when the host is focused.
+func (p *attrShadowRoot[T]) ShadowRootDelegatesFocus(value ...bool) *T {
+	p.el.addBoolean("shadowrootdelegatesfocus", value...)
+	return p.t
+}
+
+// ShadowRootClonable copies the shadow root when the host is cloned.
+func (p *attrShadowRoot[T]) ShadowRootClonable(value ...bool) *T {
+	p.el.addBoolean("shadowrootclonable", value...)
+	return p.t
+}
+
+// ShadowRootSerializable includes the shadow root when the host is
+// serialized with getHTML().
+func (p *attrShadowRoot[T]) ShadowRootSerializable(value ...bool) *T {
+	p.el.addBoolean("shadowrootserializable", value...)
+	return p.t
+}
+
+// ShadowRootCustomElementRegistry leaves the shadow root without custom
+// element registry, so script can set a scoped one.
+func (p *attrShadowRoot[T]) ShadowRootCustomElementRegistry(value ...bool) *T {
+	p.el.addBoolean("shadowrootcustomelementregistry", value...)
 	return p.t
 }
 
@@ -1648,9 +2644,23 @@ type attrShape[T any] struct {
 	attribute[T]
 }
 
-// Shape requests a value that is advisable to obtain from official documentation.
-func (p *attrShape[T]) Shape() *T {
-	p.el.addAttribute("shape")
+// Shape is the shape of an <area>, the value of the shape attribute.
+type Shape string
+
+const (
+	// ShapeRect is a rectangle (default).
+	ShapeRect   Shape = "rect"
+	ShapeCircle Shape = "circle"
+	// ShapePoly is a polygon.
+	ShapePoly Shape = "poly"
+	// ShapeDefault is the entire image.
+	ShapeDefault Shape = "default"
+)
+
+// Shape specifies the shape of the area. The coords attribute gives its size
+// and position.
+func (p *attrShape[T]) Shape(value Shape) *T {
+	addKeyword(p.el, "shape", value, ShapeRect, ShapeCircle, ShapePoly, ShapeDefault)
 	return p.t
 }
 
@@ -1716,6 +2726,20 @@ func (p *attrSrc[T]) Src(value string) *T {
 	return p.t
 }
 
+// #region srcdoc
+// <iframe>
+
+type attrSrcDoc[T any] struct {
+	attribute[T]
+}
+
+// SrcDoc is the HTML of the page shown in the iframe. It takes precedence
+// over Src.
+func (p *attrSrcDoc[T]) SrcDoc(value string) *T {
+	p.el.addAttribute("srcdoc", value)
+	return p.t
+}
+
 // #region srclang
 // <track>
 
@@ -1777,6 +2801,22 @@ type attrTarget[T any] struct {
 	attribute[T]
 }
 
+// Target is a browsing context, the value of the target and formtarget
+// attributes. It is a name or one of the keywords that start with an
+// underscore.
+type Target string
+
+const (
+	// TargetSelf is the current browsing context (default).
+	TargetSelf Target = "_self"
+	// TargetBlank is a new browsing context.
+	TargetBlank Target = "_blank"
+	// TargetParent is the parent of the current browsing context.
+	TargetParent Target = "_parent"
+	// TargetTop is the topmost browsing context.
+	TargetTop Target = "_top"
+)
+
 // Target specifies where to open the linked document (in the case of an
 // <a> element) or where to display the response received (in the case of a
 // <form> element).
@@ -1786,11 +2826,22 @@ type attrTarget[T any] struct {
 //   - _blank: show the result in a new, unnamed browsing context.
 //   - _parent: show the result in the parent browsing context of the current one, if the current page is inside a frame. If there is no parent, acts the same as _self.
 //   - _top: show the result in the topmost browsing context (the browsing context that is an ancestor of the current one and has no parent). If there is no parent, acts the same as _self.
-func (p *attrTarget[T]) Target(value string) *T {
-	p.el.addAttribute("target", value)
+func (p *attrTarget[T]) Target(value Target) *T {
+	addTarget(p.el, "target", value)
 	return p.t
 }
 
+// addTarget sets an attribute whose value is a Target. Only the values that
+// start with an underscore are keywords; the others are names of browsing
+// contexts, which are not checked.
+func addTarget(p *element, name string, value Target) {
+	if value != "" && !strings.HasPrefix(string(value), "_") {
+		p.addAttribute(name, string(value))
+		return
+	}
+	addKeyword(p, name, value, TargetSelf, TargetBlank, TargetParent, TargetTop)
+}
+
 // #region type
 // <button>, <input>, <embed>, <object>, <ol>, <script>, <source>, <style>,
 // <menu>, <link>
@@ -1805,6 +2856,19 @@ func (p *attrType[T]) Type(value string) *T {
 	return p.t
 }
 
+// #region usemap
+// <img>
+
+type attrUseMap[T any] struct {
+	attribute[T]
+}
+
+// UseMap associates the image with an image map, like "#shapes".
+func (p *attrUseMap[T]) UseMap(value string) *T {
+	p.el.addAttribute("usemap", value)
+	return p.t
+}
+
 // #region value
 // <button>, <data>, <input>, <li>, <meter>, <option>, <progress>
 
@@ -1818,6 +2882,20 @@ func (p *attrValue[T]) Value(value string) *T {
 	return p.t
 }
 
+// #region width
+// <canvas>, <embed>, <fencedframe>, <iframe>, <img>, <input>, <object>,
+// <source>, <video>
+
+type attrWidth[T any] struct {
+	attribute[T]
+}
+
+// Width specifies the width of the element in CSS pixels.
+func (p *attrWidth[T]) Width(value int) *T {
+	p.el.addAttribute("width", value)
+	return p.t
+}
+
 // #region wrap
 // <textarea>
 
@@ -1825,14 +2903,24 @@ type attrWrap[T any] struct {
 	attribute[T]
 }
 
+// Wrap is a value of the wrap attribute.
+type Wrap string
+
+const (
+	// WrapHard inserts line breaks in the submitted value.
+	WrapHard Wrap = "hard"
+	// WrapSoft does not add line breaks (default).
+	WrapSoft Wrap = "soft"
+)
+
 // Wrap specifies how the text in a text area is wrapped.
 // Indicates how the control should wrap the value for form submission.
 //
 // Possible values are:
 //   - hard: the browser automatically inserts line breaks (CR+LF) so that each line is no longer than the width of the control; the cols attribute must be specified for this to take effect.
 //   - soft: the browser ensures that all line breaks in the entered value are a CR+LF pair, but no additional line breaks are added to the value.
-func (p *attrWrap[T]) Wrap(value string) *T {
-	p.el.addAttribute("wrap", value)
+func (p *attrWrap[T]) Wrap(value Wrap) *T {
+	addKeyword(p.el, "wrap", value, WrapHard, WrapSoft)
 	return p.t
 }
 
@@ -1853,12 +2941,17 @@ type attrOn[T any] struct {
 // [event reference]: https://developer.mozilla.org/en-US/docs/Web/Events
 // [documentation]: https://developer.mozilla.org/en-US/docs/Web/API/Element#events
 func (p *attrOn[T]) On(eventName, script string) *T {
-	eventName = strings.ToLower(eventName)
-	if eventName[:2] == "on" {
-		p.el.addAttribute(eventName, script)
-	} else {
-		p.el.addAttribute("on"+eventName, script)
+	eventName = strings.ToLower(strings.TrimSpace(eventName))
+	if !strings.HasPrefix(eventName, "on") {
+		eventName = "on" + eventName
+	}
+	if eventName == "on" {
+		if Strict {
+			p.el.addError(fmt.Errorf("%w: missing event name", ErrInvalidAttribute))
+		}
+		return p.t
 	}
 
+	p.el.addAttribute(eventName, script)
 	return p.t
 }
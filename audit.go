@@ -0,0 +1,279 @@
+package renderHTML
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// #region ACCESSIBILITY AUDIT
+
+// AuditRule identifies an accessibility check of Audit.
+type AuditRule string
+
+const (
+	// RuleImgAlt reports an <img> without alt attribute. Decorative images
+	// must have an empty alt.
+	RuleImgAlt AuditRule = "img-alt"
+
+	// RuleFormLabel reports an <input>, <select> or <textarea> without a
+	// label: a <label> with its id in the for attribute, an ancestor <label>,
+	// or the aria-label or aria-labelledby attributes.
+	RuleFormLabel AuditRule = "form-label"
+
+	// RuleHeadingOrder reports a heading that skips levels, like an <h4>
+	// after an <h2>.
+	RuleHeadingOrder AuditRule = "heading-order"
+
+	// RuleAriaRole reports a role attribute with a value that is not a
+	// WAI-ARIA role.
+	RuleAriaRole AuditRule = "aria-role"
+
+	// RuleDuplicateID reports an id used by several elements when it is
+	// referenced by aria-* attributes or by the for attribute of a <label>,
+	// as only the first element would be found.
+	RuleDuplicateID AuditRule = "duplicate-id"
+
+	// RuleTableHeaders reports a <table> without <th> cells.
+	RuleTableHeaders AuditRule = "table-headers"
+
+	// RuleTableCaption reports a <table> without <caption>, aria-label or
+	// aria-labelledby.
+	RuleTableCaption AuditRule = "table-caption"
+
+	// RuleHtmlLang reports an <html> element without lang attribute.
+	RuleHtmlLang AuditRule = "html-lang"
+)
+
+// AuditIssue is an accessibility problem found by Audit. Its fields have
+// JSON tags, so the results can be stored or compared by tools.
+type AuditIssue struct {
+	Rule AuditRule `json:"rule"`
+
+	// Path locates the element from the audited element, like
+	// "body > form > input:nth-child(2)". It is a valid selector for Query.
+	Path string `json:"path"`
+
+	Message string `json:"message"`
+}
+
+// String returns the issue as a line of text.
+func (i AuditIssue) String() string {
+	return i.Path + ": " + i.Message + " (" + string(i.Rule) + ")"
+}
+
+// Audit checks the element and its descendants for common accessibility
+// problems (WCAG), like images without alternative text or form fields
+// without label. See the AuditRule constants for the checks.
+//
+// It returns nil when no problem is found. Audit doesn't replace manual
+// testing: it only finds the problems visible in the HTML.
+//
+// Example:
+//
+//	for _, issue := range page.Audit() {
+//		t.Error(issue)
+//	}
+func (p *element) Audit() []AuditIssue {
+	a := &auditor{ids: map[string]int{}, labelFor: map[string]bool{}}
+	if p.tag == "" {
+		a.collect(p, "", false)
+	} else {
+		a.nodes = append(a.nodes, auditNode{p, p.tag, false})
+		a.count(p)
+		a.collect(p, p.tag, p.tag == "label")
+	}
+	return a.audit()
+}
+
+// ariaRoles are the roles defined by WAI-ARIA 1.2 and its graphics module.
+var ariaRoles = []string{
+	"alert", "alertdialog", "application", "article", "banner", "blockquote",
+	"button", "caption", "cell", "checkbox", "code", "columnheader",
+	"combobox", "complementary", "contentinfo", "definition", "deletion",
+	"dialog", "directory", "document", "emphasis", "feed", "figure", "form",
+	"generic", "graphics-document", "graphics-object", "graphics-symbol",
+	"grid", "gridcell", "group", "heading", "img", "insertion", "link", "list",
+	"listbox", "listitem", "log", "main", "marquee", "math", "menu", "menubar",
+	"menuitem", "menuitemcheckbox", "menuitemradio", "meter", "navigation",
+	"none", "note", "option", "paragraph", "presentation", "progressbar",
+	"radio", "radiogroup", "region", "row", "rowgroup", "rowheader",
+	"scrollbar", "search", "searchbox", "separator", "slider", "spinbutton",
+	"status", "strong", "subscript", "superscript", "switch", "tab", "table",
+	"tablist", "tabpanel", "term", "textbox", "time", "timer", "toolbar",
+	"tooltip", "tree", "treegrid", "treeitem",
+}
+
+// idReferences are the attributes whose value is a list of ids.
+var idReferences = []string{
+	"aria-activedescendant", "aria-controls", "aria-describedby",
+	"aria-details", "aria-errormessage", "aria-flowto", "aria-labelledby",
+	"aria-owns",
+}
+
+// auditNode is an element of the audited tree.
+type auditNode struct {
+	el   *element
+	path string
+
+	// inLabel reports whether the element has a <label> ancestor.
+	inLabel bool
+}
+
+type auditor struct {
+	nodes    []auditNode
+	ids      map[string]int
+	labelFor map[string]bool
+	issues   []AuditIssue
+}
+
+// collect adds the descendants of el to the nodes, in document order.
+func (a *auditor) collect(el *element, path string, inLabel bool) {
+	siblings := el.children()
+	for i, n := range siblings {
+		childPath := stepPath(n.el.tag, i, siblings)
+		if path != "" {
+			childPath = path + " > " + childPath
+		}
+
+		a.nodes = append(a.nodes, auditNode{n.el, childPath, inLabel})
+		a.count(n.el)
+		a.collect(n.el, childPath, inLabel || n.el.tag == "label")
+	}
+}
+
+// count records the id of el and the ids it references.
+func (a *auditor) count(el *element) {
+	if id, ok := el.attributeValue("id"); ok && id != "" {
+		a.ids[id]++
+	}
+	if el.tag == "label" {
+		if id, ok := el.attributeValue("for"); ok {
+			a.labelFor[id] = true
+		}
+	}
+}
+
+func (a *auditor) add(rule AuditRule, path, format string, args ...any) {
+	a.issues = append(a.issues, AuditIssue{Rule: rule, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (a *auditor) audit() []AuditIssue {
+	lastHeading := 0
+	for _, n := range a.nodes {
+		el := n.el
+
+		switch el.tag {
+		case "html":
+			if lang, _ := el.attributeValue("lang"); strings.TrimSpace(lang) == "" {
+				a.add(RuleHtmlLang, n.path, "<html> has no lang attribute")
+			}
+		case "img":
+			if _, ok := el.attributeValue("alt"); !ok {
+				a.add(RuleImgAlt, n.path, "<img> has no alt attribute")
+			}
+		case "input", "select", "textarea":
+			a.auditLabel(n)
+		case "table":
+			a.auditTable(n)
+		case "h1", "h2", "h3", "h4", "h5", "h6":
+			level := int(el.tag[1] - '0')
+			if lastHeading > 0 && level > lastHeading+1 {
+				a.add(RuleHeadingOrder, n.path, "<%s> skips heading levels after <h%d>", el.tag, lastHeading)
+			}
+			lastHeading = level
+		}
+
+		if role, ok := el.attributeValue("role"); ok {
+			for _, r := range strings.Fields(role) {
+				if !slices.Contains(ariaRoles, strings.ToLower(r)) {
+					a.add(RuleAriaRole, n.path, "invalid role %q", r)
+				}
+			}
+		}
+
+		a.auditReferences(n)
+	}
+
+	return a.issues
+}
+
+// labelled reports whether el has an accessible name given by aria-label or
+// aria-labelledby.
+func labelled(el *element) bool {
+	for _, name := range []string{"aria-label", "aria-labelledby"} {
+		if v, ok := el.attributeValue(name); ok && strings.TrimSpace(v) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *auditor) auditLabel(n auditNode) {
+	el := n.el
+	if el.tag == "input" {
+		typ, _ := el.attributeValue("type")
+		switch strings.ToLower(typ) {
+		case "hidden", "submit", "reset", "button", "image":
+			// they don't need a label, or it's their value or alt
+			return
+		}
+	}
+
+	if n.inLabel || labelled(el) {
+		return
+	}
+	if id, ok := el.attributeValue("id"); ok && a.labelFor[id] {
+		return
+	}
+
+	a.add(RuleFormLabel, n.path, "<%s> has no label", el.tag)
+}
+
+func (a *auditor) auditTable(n auditNode) {
+	if role, _ := n.el.attributeValue("role"); role == "presentation" || role == "none" {
+		return
+	}
+
+	hasHeaders, hasCaption := tableContains(n.el, "th"), tableContains(n.el, "caption")
+
+	if !hasHeaders {
+		a.add(RuleTableHeaders, n.path, "<table> has no header cells (<th>)")
+	}
+	if !hasCaption && !labelled(n.el) {
+		a.add(RuleTableCaption, n.path, "<table> has no <caption>, aria-label or aria-labelledby")
+	}
+}
+
+// tableContains reports whether the table has a descendant with the tag,
+// without looking into nested tables, which are checked on their own.
+func tableContains(table *element, tag string) bool {
+	for _, n := range table.children() {
+		if n.el.tag == tag || n.el.tag != "table" && tableContains(n.el, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditReferences reports the ids referenced by the element that are used by
+// several elements.
+func (a *auditor) auditReferences(n auditNode) {
+	var refs []string
+	for _, name := range idReferences {
+		if v, ok := n.el.attributeValue(name); ok {
+			refs = append(refs, strings.Fields(v)...)
+		}
+	}
+	if n.el.tag == "label" {
+		if v, ok := n.el.attributeValue("for"); ok {
+			refs = append(refs, v)
+		}
+	}
+
+	for _, id := range refs {
+		if count := a.ids[id]; count > 1 {
+			a.add(RuleDuplicateID, n.path, "the referenced id %q is used by %d elements", id, count)
+		}
+	}
+}
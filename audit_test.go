@@ -0,0 +1,63 @@
+package renderHTML
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	tests := []struct {
+		name string
+		root interface{ Audit() []AuditIssue }
+		want []string
+	}{
+		{"accessible", Html(Body(
+			H1("a"), H2("b"), H3("c"), H2("d"),
+			Img().Src("a.png").Alt(""),
+			Form(
+				Label("Name", Input().Name("n")),
+				Label("Mail").For("mail"), Input().Id("mail"),
+				Select(Option("a")).Aria("label", "choice"),
+				Input().Type("submit"),
+			),
+			Table(Caption("t"), Tr(Th("h")), Tr(Td("d"))),
+			Table(Tr(Td("layout"))).Role("presentation"),
+			Nav().Role("navigation"),
+		)).Lang("en"), nil},
+		{"lang", Html(Body()), []string{"html: <html> has no lang attribute (html-lang)"}},
+		{"img", Div(P(Img().Src("a.png"))), []string{"div > p > img: <img> has no alt attribute (img-alt)"}},
+		{"label", Form(Input().Id("x"), Textarea()), []string{
+			"form > input: <input> has no label (form-label)",
+			"form > textarea: <textarea> has no label (form-label)",
+		}},
+		{"headings", Container(H1("a"), H3("b"), H2("c"), H4("d")), []string{
+			"h3: <h3> skips heading levels after <h1> (heading-order)",
+			"h4: <h4> skips heading levels after <h2> (heading-order)",
+		}},
+		{"role", Div().Role("buton"), []string{`div: invalid role "buton" (aria-role)`}},
+		{"duplicate id", Div(Span("a").Id("d"), Span("b").Id("d"), Button().Aria("describedby", "d").Aria("label", "x")), []string{
+			`div > button: the referenced id "d" is used by 2 elements (duplicate-id)`,
+		}},
+		{"table", Table(Tr(Td(Table(Caption("c"), Tr(Th("h")))))), []string{
+			"table: <table> has no header cells (<th>) (table-headers)",
+			"table: <table> has no <caption>, aria-label or aria-labelledby (table-caption)",
+		}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, issue := range tt.root.Audit() {
+			got = append(got, issue.String())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%v: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	data, err := json.Marshal(Div(Img()).Audit())
+	want := `[{"rule":"img-alt","path":"div \u003e img","message":"\u003cimg\u003e has no alt attribute"}]`
+	if err != nil || string(data) != want {
+		t.Errorf("json: got %s, %v, want %s", data, err, want)
+	}
+}
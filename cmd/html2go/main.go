@@ -0,0 +1,544 @@
+// Command html2go converts an HTML file into Go source code that builds the
+// same HTML with renderHTML.
+//
+// Usage:
+//
+//	html2go [flags] [file.html]
+//
+// The HTML is read from the file, or from the standard input when no file is
+// given. The flags are:
+//
+//	-package name
+//		package of the generated file (default "main")
+//	-func name
+//		name of the generated function (default "view")
+//	-o file
+//		write the output to file instead of the standard output
+//
+// Every tag becomes a call to its constructor, like Div or Input, and every
+// attribute a call to its typed method, like Class, Id or Href. The
+// attributes without a typed method are added with AddAttributes. The
+// whitespace used to indent the HTML is removed.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"html"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hypermediastack/renderHTML"
+)
+
+func main() {
+	pkg := flag.String("package", "main", "package of the generated file")
+	fn := flag.String("func", "view", "name of the generated function")
+	out := flag.String("o", "", "write the output to `file` instead of the standard output")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: html2go [flags] [file.html]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*pkg, *fn, *out, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "html2go:", err)
+		os.Exit(1)
+	}
+}
+
+func run(pkg, fn, out string, args []string) error {
+	var in io.Reader = os.Stdin
+	source := "stdin"
+	switch len(args) {
+	case 0:
+	case 1:
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in, source = f, args[0]
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	root, err := renderHTML.Parse(in)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(pkg, fn, source, root)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
+
+// element is implemented by all the elements of renderHTML.
+type element interface {
+	fmt.Stringer
+	Tag() string
+	Attributes() []renderHTML.Attribute
+	Classes() []string
+	Styles() []string
+	Children() []fmt.Stringer
+}
+
+// generate returns the formatted source of a file with a function that
+// returns root.
+func generate(pkg, fn, source string, root *renderHTML.UntaggedElement) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Generated by html2go from %s.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n\t\"fmt\"\n\n\t. \"github.com/hypermediastack/renderHTML\"\n)\n\n")
+	fmt.Fprintf(&b, "func %s() fmt.Stringer {\n\treturn ", fn)
+
+	children := nodes(root)
+	if len(children) == 1 {
+		if el, ok := children[0].(element); ok {
+			writeElement(&b, el)
+			children = nil
+		}
+	}
+	if children != nil {
+		writeCall(&b, "Container", nil, children)
+	}
+
+	b.WriteString("\n}\n")
+
+	return format.Source(b.Bytes())
+}
+
+// #region nodes
+
+// text is a text node of the HTML.
+type text string
+
+func (t text) String() string { return string(t) }
+
+// comment is a comment of the HTML.
+type comment string
+
+func (c comment) String() string { return string(c) }
+
+const space = " \t\r\n\f"
+
+// preformatted are the elements whose whitespace is kept.
+var preformatted = map[string]bool{"pre": true, "textarea": true, "script": true, "style": true}
+
+// nodes returns the children of el, converting the texts and comments, and
+// removing the whitespace used to indent the HTML.
+func nodes(el element) []fmt.Stringer {
+	var list []fmt.Stringer
+	for _, child := range el.Children() {
+		switch c := child.(type) {
+		case element:
+			list = append(list, c)
+		default:
+			s := c.String()
+			switch {
+			case preformatted[el.Tag()]:
+				list = append(list, text(html.UnescapeString(s)))
+			case strings.HasPrefix(s, "<!--"):
+				// the text is escaped, so it can't contain a "<"
+				list = append(list, comment(s))
+			default:
+				list = append(list, text(html.UnescapeString(s)))
+			}
+		}
+	}
+
+	if preformatted[el.Tag()] {
+		return list
+	}
+
+	// indentation: whitespace with new lines
+	var clean []fmt.Stringer
+	for i, node := range list {
+		t, ok := node.(text)
+		if !ok {
+			clean = append(clean, node)
+			continue
+		}
+
+		s := string(t)
+		if i == 0 {
+			s = trimIndent(s, true)
+		}
+		if i == len(list)-1 {
+			s = trimIndent(s, false)
+		}
+		if strings.TrimSpace(s) == "" && strings.Contains(s, "\n") {
+			continue
+		}
+		if s != "" {
+			clean = append(clean, text(collapseSpace(s)))
+		}
+	}
+	return clean
+}
+
+// trimIndent trims the leading or trailing whitespace of s when it contains
+// a new line.
+func trimIndent(s string, leading bool) string {
+	t := strings.TrimRight(s, space)
+	removed := s[len(t):]
+	if leading {
+		t = strings.TrimLeft(s, space)
+		removed = s[:len(s)-len(t)]
+	}
+
+	if strings.Contains(removed, "\n") {
+		return t
+	}
+	return s
+}
+
+// collapseSpace replaces the whitespace with new lines by a single space, as
+// browsers render them.
+func collapseSpace(s string) string {
+	if !strings.Contains(s, "\n") {
+		return s
+	}
+
+	c := strings.Join(strings.Fields(s), " ")
+	if strings.TrimLeft(s, space) != s {
+		c = " " + c
+	}
+	if strings.TrimRight(s, space) != s {
+		c += " "
+	}
+	return c
+}
+
+// #region writing
+
+// writeCall writes a call of fn with the arguments followed by the nodes,
+// one per line when there are several elements or any of them has elements.
+func writeCall(b *bytes.Buffer, fn string, args []string, list []fmt.Stringer) {
+	b.WriteString(fn)
+	b.WriteString("(")
+	b.WriteString(strings.Join(args, ", "))
+
+	multiline := nested(list) || countElements(list) > 1
+	if len(args) > 0 && len(list) > 0 {
+		b.WriteString(",")
+		if !multiline {
+			b.WriteString(" ")
+		}
+	}
+	for i, node := range list {
+		switch {
+		case multiline:
+			b.WriteString("\n")
+		case i > 0:
+			b.WriteString(", ")
+		}
+
+		switch n := node.(type) {
+		case element:
+			writeElement(b, n)
+		case comment:
+			writeRawString(b, string(n))
+		default:
+			b.WriteString(quote(n.String()))
+		}
+
+		if multiline {
+			b.WriteString(",")
+		}
+	}
+	if multiline {
+		b.WriteString("\n")
+	}
+
+	b.WriteString(")")
+}
+
+// nested reports whether any of the nodes is an element with elements.
+func nested(list []fmt.Stringer) bool {
+	for _, node := range list {
+		if el, ok := node.(element); ok && hasElements(nodes(el)) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasElements(list []fmt.Stringer) bool {
+	return countElements(list) > 0
+}
+
+func countElements(list []fmt.Stringer) int {
+	n := 0
+	for _, node := range list {
+		if _, ok := node.(element); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func writeRawString(b *bytes.Buffer, s string) {
+	if strings.Contains(s, "%") {
+		fmt.Fprintf(b, "RawString(\"%%s\", %s)", quote(s))
+		return
+	}
+	fmt.Fprintf(b, "RawString(%s)", quote(s))
+}
+
+// writeElement writes the constructor of el followed by the methods that set
+// its attributes.
+func writeElement(b *bytes.Buffer, el element) {
+	t := reflect.TypeOf(el)
+	name := strings.TrimSuffix(t.Elem().Name(), "Element")
+	var args []string
+	if name == "Custom" {
+		if !renderHTML.ValidCustomElementName(el.Tag()) {
+			// the unknown elements, like those of SVG and MathML, are not
+			// custom elements and can't be written with Custom
+			writeRawString(b, el.String())
+			return
+		}
+		args = []string{quote(el.Tag())}
+	}
+
+	if _, ok := t.MethodByName("AddContent"); ok {
+		writeCall(b, name, args, nodes(el))
+	} else {
+		b.WriteString(name + "()")
+	}
+
+	if classes := el.Classes(); len(classes) > 0 {
+		writeMethod(b, t, "Class", quoteAll(classes), `class="`+strings.Join(classes, " ")+`"`)
+	}
+	if styles := el.Styles(); len(styles) > 0 {
+		writeMethod(b, t, "Style", quoteAll(styles), `style="`+strings.Join(styles, " ")+`"`)
+	}
+
+	var untyped []string
+	for _, a := range el.Attributes() {
+		if call, ok := typedSetter(el.Tag(), t, a); ok {
+			b.WriteString(call)
+		} else {
+			untyped = append(untyped, attributeString(a))
+		}
+	}
+	if len(untyped) > 0 {
+		writeMethod(b, t, "AddAttributes", quoteAll(untyped), strings.Join(untyped, " "))
+	}
+}
+
+// writeMethod writes a call of the method with the arguments. The elements
+// without the method get a comment with the attributes instead.
+func writeMethod(b *bytes.Buffer, t reflect.Type, method string, args []string, attrs string) {
+	if _, ok := t.MethodByName(method); !ok {
+		fmt.Fprintf(b, " /* unsupported attributes: %s */", strings.ReplaceAll(attrs, "*/", "* /"))
+		return
+	}
+	fmt.Fprintf(b, ".%s(%s)", method, strings.Join(args, ", "))
+}
+
+// quote returns s as a Go string literal. The strings with double quotes
+// are written as raw strings when possible, as they are easier to read.
+func quote(s string) string {
+	if strings.Contains(s, `"`) && strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+func quoteAll(list []string) []string {
+	q := make([]string, len(list))
+	for i, s := range list {
+		q[i] = quote(s)
+	}
+	return q
+}
+
+func attributeString(a renderHTML.Attribute) string {
+	if !a.HasValue {
+		return a.Name
+	}
+	return a.Name + `="` + html.EscapeString(a.Value) + `"`
+}
+
+// #region typed setters
+
+// setter is a method that could set an attribute, with its arguments.
+type setter struct {
+	method string
+	args   []reflect.Value
+}
+
+// typedSetter returns the call of the typed method that sets the attribute
+// a in the elements of type t. The candidate methods are found by name and
+// checked by calling them on a new element of the same type, so only those
+// that produce exactly the same attribute are used.
+func typedSetter(tag string, t reflect.Type, a renderHTML.Attribute) (string, bool) {
+	for _, c := range candidates(t, a) {
+		if !produces(tag, t, c, a) {
+			continue
+		}
+
+		args := make([]string, len(c.args))
+		for i, v := range c.args {
+			switch v.Kind() {
+			case reflect.String:
+				args[i] = quote(v.String())
+			default:
+				args[i] = fmt.Sprint(v.Interface())
+			}
+		}
+		return "." + c.method + "(" + strings.Join(args, ", ") + ")", true
+	}
+
+	return "", false
+}
+
+// candidates returns the methods of t that could set the attribute.
+func candidates(t reflect.Type, a renderHTML.Attribute) []setter {
+	var list []setter
+
+	name := strings.NewReplacer("-", "", ":", "", "_", "").Replace(a.Name)
+	for i := range t.NumMethod() {
+		m := t.Method(i)
+		switch {
+		case strings.EqualFold(m.Name, name):
+			if args, ok := valueArgs(m.Type, a); ok {
+				list = append(list, setter{m.Name, args})
+			}
+
+		case strings.HasPrefix(a.Name, strings.ToLower(m.Name)+"-") && a.HasValue && stringPair(m.Type):
+			// Data("name", value), Aria("name", value)
+			rest := a.Name[len(m.Name)+1:]
+			list = append(list, setter{m.Name, []reflect.Value{reflect.ValueOf(rest), reflect.ValueOf(a.Value)}})
+
+		case m.Name == "On" && strings.HasPrefix(a.Name, "on") && len(a.Name) > 2 && stringPair(m.Type):
+			list = append(list, setter{m.Name, []reflect.Value{reflect.ValueOf(a.Name[2:]), reflect.ValueOf(a.Value)}})
+		}
+	}
+
+	return list
+}
+
+// valueArgs returns the arguments of the method of type mt to set the value
+// of the attribute. The first input of mt is the receiver.
+func valueArgs(mt reflect.Type, a renderHTML.Attribute) ([]reflect.Value, bool) {
+	if mt.NumOut() != 1 || mt.Out(0) != mt.In(0) {
+		return nil, false
+	}
+
+	switch mt.NumIn() {
+	case 1:
+		// boolean attributes like Hidden()
+		return nil, !a.HasValue || a.Value == ""
+	case 2:
+	default:
+		return nil, false
+	}
+
+	in := mt.In(1)
+	if mt.IsVariadic() {
+		if !a.HasValue || a.Value == "" {
+			return nil, true
+		}
+		in = in.Elem()
+	}
+
+	var v reflect.Value
+	switch in.Kind() {
+	case reflect.String:
+		v = reflect.ValueOf(a.Value).Convert(in)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(a.Value)
+		if err != nil {
+			return nil, false
+		}
+		v = reflect.ValueOf(b).Convert(in)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(a.Value, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		v = reflect.New(in).Elem()
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(a.Value, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		v = reflect.New(in).Elem()
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(a.Value, 64)
+		if err != nil {
+			return nil, false
+		}
+		v = reflect.New(in).Elem()
+		v.SetFloat(f)
+	default:
+		return nil, false
+	}
+
+	return []reflect.Value{v}, true
+}
+
+// stringPair reports whether the method of type mt receives two strings.
+func stringPair(mt reflect.Type) bool {
+	return mt.NumIn() == 3 && !mt.IsVariadic() &&
+		mt.In(1).Kind() == reflect.String && mt.In(2).Kind() == reflect.String &&
+		mt.NumOut() == 1 && mt.Out(0) == mt.In(0)
+}
+
+// produces reports whether calling the setter on a new element of type t
+// results in exactly the attribute a.
+func produces(tag string, t reflect.Type, s setter, a renderHTML.Attribute) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+
+	el, found := newElement(tag, t)
+	if !found {
+		return false
+	}
+
+	reflect.ValueOf(el).MethodByName(s.method).Call(s.args)
+
+	attrs := el.Attributes()
+	return len(attrs) == 1 && attrs[0] == a
+}
+
+// newElement returns a new element of type t, parsed from its tag. The tags
+// are also parsed inside <svg> and <math>, where they are SVG and MathML
+// elements.
+func newElement(tag string, t reflect.Type) (element, bool) {
+	for _, s := range []string{"<" + tag + ">", "<svg><" + tag + ">", "<math><" + tag + ">"} {
+		children := renderHTML.ParseString(s).Children()
+		for len(children) == 1 {
+			el, ok := children[0].(element)
+			if !ok {
+				break
+			}
+			if reflect.TypeOf(el) == t {
+				return el, true
+			}
+			children = el.Children()
+		}
+	}
+	return nil, false
+}
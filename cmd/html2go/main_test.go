@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hypermediastack/renderHTML"
+)
+
+func TestGenerate(t *testing.T) {
+	root := renderHTML.ParseString(`
+<div class="card" id="c1" data-id="7">
+  <label for="q">Search <b>now</b></label>
+  <input type="text" name="q" required tabindex="2" hx-get="/search">
+  <sl-button variant="primary" slot="footer">Go</sl-button>
+</div>`)
+
+	src, err := generate("views", "card", "card.html", root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `func card() fmt.Stringer {
+	return Div(
+		Label("Search ", B("now")).For("q"),
+		Input().Type("text").Name("q").Required().TabIndex(2).AddAttributes(` + "`" + `hx-get="/search"` + "`" + `),
+		Custom("sl-button", "Go").Slot("footer").AddAttributes(` + "`" + `variant="primary"` + "`" + `),
+	).Class("card").Id("c1").Data("id", "7")
+}
+`
+	if got := string(src); !strings.HasSuffix(got, want) {
+		t.Errorf("got %v, want suffix %v", got, want)
+	}
+	if !strings.HasPrefix(string(src), "// Generated by html2go from card.html.\n\npackage views\n") {
+		t.Errorf("unexpected header %v", string(src))
+	}
+}
+
+func TestGenerateUnknown(t *testing.T) {
+	root := renderHTML.ParseString(`<div><foo>a</foo><svg><filter id="f"><feMerge></feMerge></filter></svg></div>`)
+
+	src, err := generate("views", "unknown", "unknown.html", root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `func unknown() fmt.Stringer {
+	return Div(
+		RawString("<foo>a</foo>"),
+		Svg(
+			RawString(` + "`" + `<filter id="f"><feMerge/></filter>` + "`" + `),
+		),
+	)
+}
+`
+	if got := string(src); !strings.HasSuffix(got, want) {
+		t.Errorf("got %v, want suffix %v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package renderHTML
+
+import (
+	"context"
+	"fmt"
+)
+
+// #region COMPONENTS
+
+// Component is a reusable piece of the interface, like a card, a dialog or a
+// form field. Its props are the fields of the type that implements it, and
+// its content is given by Children when it is rendered, along with the
+// context of the rendering, like the context of the HTTP request in Respond.
+//
+// A Component can be used as the content of any element, like a fmt.Stringer.
+// Use adds content and named slots to it. A nil component, like a nil
+// ComponentFunc or a nil pointer, renders nothing.
+//
+// Example:
+//
+//	type Card struct {
+//		Title string
+//	}
+//
+//	func (c Card) Render(ctx context.Context, children Children) fmt.Stringer {
+//		card := Article(H2(c.Title), children.Content()).Class("card")
+//		if children.Has("footer") {
+//			card.AddContent(Footer(children.Slot("footer")))
+//		}
+//		return card
+//	}
+//
+//	Main(
+//		Card{Title: "Welcome"},
+//		Use(Card{Title: "Orders"}, P("No orders yet.")).Slot("footer", A("New order").Href("/orders/new")),
+//	)
+type Component interface {
+	Render(ctx context.Context, children Children) fmt.Stringer
+}
+
+// ComponentFunc lets a function be used as a Component.
+type ComponentFunc func(ctx context.Context, children Children) fmt.Stringer
+
+// Render calls f(ctx, children).
+func (f ComponentFunc) Render(ctx context.Context, children Children) fmt.Stringer {
+	return f(ctx, children)
+}
+
+// Children is the content given to a component with Use: the content without
+// slot and the content of each named slot.
+type Children struct {
+	content *UntaggedElement
+	slots   map[string]*UntaggedElement
+}
+
+// Content returns the content given to the component without slot.
+func (c Children) Content() *UntaggedElement {
+	if c.content == nil {
+		return Container()
+	}
+	return c.content
+}
+
+// Slot returns the content of the slot called name.
+func (c Children) Slot(name string) *UntaggedElement {
+	if name == "" {
+		return c.Content()
+	}
+	if s, ok := c.slots[name]; ok {
+		return s
+	}
+	return Container()
+}
+
+// Has reports whether the slot called name has content. The empty name is
+// the content without slot.
+func (c Children) Has(name string) bool {
+	if name == "" {
+		return c.content != nil && len(c.content.content) > 0
+	}
+	s, ok := c.slots[name]
+	return ok && len(s.content) > 0
+}
+
+// ComponentElement is a component with the content it receives when it is
+// rendered.
+//
+// Note: the elements returned by the component only exist while it is
+// rendered, so ById, Query, Validate, Audit and Errors don't look into them.
+type ComponentElement struct {
+	component Component
+	children  Children
+}
+
+// Use gives content to the component. The content is rendered where the
+// component places children.Content().
+func Use(component Component, content ...any) *ComponentElement {
+	return (&ComponentElement{component: component}).AddContent(content...)
+}
+
+// AddContent adds content to the component, like Use.
+func (p *ComponentElement) AddContent(content ...any) *ComponentElement {
+	if p.children.content == nil {
+		p.children.content = Container()
+	}
+	p.children.content.AddContent(content...)
+	return p
+}
+
+// Slot adds content to the slot called name of the component, which it
+// places with children.Slot(name).
+func (p *ComponentElement) Slot(name string, content ...any) *ComponentElement {
+	if name == "" {
+		return p.AddContent(content...)
+	}
+
+	if p.children.slots == nil {
+		p.children.slots = map[string]*UntaggedElement{}
+	}
+	s, ok := p.children.slots[name]
+	if !ok {
+		s = Container()
+		p.children.slots[name] = s
+	}
+	s.AddContent(content...)
+	return p
+}
+
+// String returns HTML text of the component, rendered with
+// context.Background().
+func (p *ComponentElement) String() string {
+	return renderString(p)
+}
+
+func (p *ComponentElement) render(r *renderer) {
+	if view := p.view(r.ctx); view != nil {
+		r.renderNode(view)
+	}
+}
+
+// view renders the component with ctx. When it returns another component,
+// that component is rendered too. A nil component, or one that returns a nil
+// view like a (*DivElement)(nil), renders nothing.
+func (p *ComponentElement) view(ctx context.Context) fmt.Stringer {
+	if isNil(p.component) {
+		return nil
+	}
+	view := p.component.Render(ctx, p.children)
+	if isNil(view) {
+		return nil
+	}
+	if c, ok := view.(*ComponentElement); ok {
+		return c.view(ctx)
+	}
+	return view
+}
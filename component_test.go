@@ -0,0 +1,122 @@
+package renderHTML
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testCard struct {
+	Title string
+}
+
+func (c testCard) Render(ctx context.Context, children Children) fmt.Stringer {
+	card := Article(H2(c.Title), children.Content()).Class("card")
+	if children.Has("footer") {
+		card.AddContent(Footer(children.Slot("footer")))
+	}
+	return card
+}
+
+type userKey struct{}
+
+var greeting = ComponentFunc(func(ctx context.Context, children Children) fmt.Stringer {
+	name, _ := ctx.Value(userKey{}).(string)
+	return P("Hello, ", name, children.Content())
+})
+
+var nilView = ComponentFunc(func(ctx context.Context, children Children) fmt.Stringer {
+	return (*DivElement)(nil)
+})
+
+func TestComponent(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"props", Div(testCard{Title: "A"}), `<div><article class="card"><h2>A</h2></article></div>`},
+		{"children", Use(testCard{Title: "A"}, P("b"), "<c>"), `<article class="card"><h2>A</h2><p>b</p>&lt;c&gt;</article>`},
+		{"slot", Use(testCard{Title: "A"}).Slot("footer", "d").AddContent("e"), `<article class="card"><h2>A</h2>e<footer>d</footer></article>`},
+		{"add content", Main().AddContent(Use(greeting, "!")), `<main><p>Hello, !</p></main>`},
+		{"nil", Div((*testCard)(nil), ComponentFunc(nil), Use(nil, "a"), "b"), `<div>b</div>`},
+		{"nil view", Div(nilView, "b"), `<div>b</div>`},
+		{"nil view oob", OOB(P("main")).Swap(SwapOuterHTML, Use(nilView)), `<p>main</p>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	var s strings.Builder
+	ctx := context.WithValue(context.Background(), userKey{}, "Ada")
+	if err := Body(greeting).RenderContext(ctx, &s); err != nil || s.String() != "<body><p>Hello, Ada</p></body>" {
+		t.Errorf("context: got %v, %v", s.String(), err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(context.WithValue(req.Context(), userKey{}, "Grace"))
+	rec := httptest.NewRecorder()
+	Respond(rec, req, http.StatusOK, Div(greeting))
+	if got, want := rec.Body.String(), "<div><p>Hello, Grace</p></div>"; got != want {
+		t.Errorf("respond: got %v, want %v", got, want)
+	}
+}
+
+func TestComponentIndent(t *testing.T) {
+	got := Main(Use(testCard{Title: "A"}, P("a")), Use(testCard{Title: "B"}, P("b"), Use(greeting))).StringIndent("  ")
+	want := `<main>
+  <article class="card">
+    <h2>A</h2>
+    <p>a</p>
+  </article>
+  <article class="card">
+    <h2>B</h2>
+    <p>b</p>
+    <p>Hello, </p>
+  </article>
+</main>`
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got, want := Main(Div("a"), nilView).StringIndent("  "), "<main>\n  <div>a</div>\n</main>"; got != want {
+		t.Errorf("nil view: got %v, want %v", got, want)
+	}
+
+	if got, want := Div(Use(ComponentFunc(func(ctx context.Context, children Children) fmt.Stringer {
+		return Span("a")
+	})), Div("b")).StringIndent("  "), "<div><span>a</span><div>b</div></div>"; got != want {
+		t.Errorf("inline: got %v, want %v", got, want)
+	}
+}
+
+func TestOOBComponent(t *testing.T) {
+	cart := ComponentFunc(func(ctx context.Context, children Children) fmt.Stringer {
+		return Div(children.Content()).Id("cart")
+	})
+	rows := ComponentFunc(func(ctx context.Context, children Children) fmt.Stringer {
+		return Container(Li("a").Id("a"), Use(cart, "b"))
+	})
+
+	resp := OOB(P("main")).Swap(SwapOuterHTML, Use(cart, "2 items"), Use(rows))
+	want := `<p>main</p><div id="cart" hx-swap-oob="outerHTML">2 items</div>` +
+		`<li id="a" hx-swap-oob="outerHTML">a</li><div id="cart" hx-swap-oob="outerHTML">b</div>`
+	if got := resp.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	resp = OOB().SwapTarget(SwapBeforeEnd, "#list", Use(ComponentFunc(func(ctx context.Context, children Children) fmt.Stringer {
+		return Use(greeting)
+	})))
+	var s strings.Builder
+	ctx := context.WithValue(context.Background(), userKey{}, "Ada")
+	if err := Body(resp).RenderContext(ctx, &s); err != nil || s.String() != `<body><p hx-swap-oob="beforeend:#list">Hello, Ada</p></body>` {
+		t.Errorf("context: got %v, %v", s.String(), err)
+	}
+}
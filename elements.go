@@ -1,7 +1,12 @@
 package renderHTML
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"reflect"
+	"slices"
 	"strings"
 )
 
@@ -22,11 +27,82 @@ func (p *addContentFunc[T]) set(el *element, t *T) {
 // This attribute isn't represented in HTML. It's a method implemented by all
 // elements within the "body" to facilitate writing code by including HTML
 // elements or text within other elements.
+//
+// Strings, numbers and booleans are escaped according to the element (see
+// AutoEscape). Use RawString to include text that must not be escaped.
 func (p *addContentFunc[T]) AddContent(content ...any) *T {
 	p.el.addContent(content...)
 	return p.t
 }
 
+// PrependContent adds content at the beginning of the current element.
+func (p *addContentFunc[T]) PrependContent(content ...any) *T {
+	p.el.insertContent(0, content...)
+	return p.t
+}
+
+// InsertContent adds content before the child at the position index of the
+// current element. An index out of range adds the content at the beginning
+// (when it is negative) or at the end.
+func (p *addContentFunc[T]) InsertContent(index int, content ...any) *T {
+	p.el.insertContent(index, content...)
+	return p.t
+}
+
+// RemoveContent removes the given children from the current element. The
+// children are compared by identity, so it is used with the elements kept in
+// a variable or found by ById, Query, etc.
+//
+// Example:
+//
+//	body := Body(nav, content, footer)
+//	if print {
+//		body.RemoveContent(nav, footer)
+//	}
+func (p *addContentFunc[T]) RemoveContent(content ...fmt.Stringer) *T {
+	p.el.content = slices.DeleteFunc(p.el.content, func(c fmt.Stringer) bool {
+		return slices.ContainsFunc(content, func(r fmt.Stringer) bool {
+			return sameNode(c, r)
+		})
+	})
+	return p.t
+}
+
+// RemoveContentAt removes the child at the position index of the current
+// element. Nothing is removed when the index is out of range.
+func (p *addContentFunc[T]) RemoveContentAt(index int) *T {
+	if index >= 0 && index < len(p.el.content) {
+		p.el.content = slices.Delete(p.el.content, index, index+1)
+	}
+	return p.t
+}
+
+// ReplaceContent replaces the child old of the current element with content.
+// Nothing is changed when old is not a child of the element.
+func (p *addContentFunc[T]) ReplaceContent(old fmt.Stringer, content ...any) *T {
+	if i := slices.IndexFunc(p.el.content, func(c fmt.Stringer) bool { return sameNode(c, old) }); i >= 0 {
+		p.el.content = slices.Delete(p.el.content, i, i+1)
+		p.el.insertContent(i, content...)
+	}
+	return p.t
+}
+
+// ReplaceContentAt replaces the child at the position index of the current
+// element with content. Nothing is changed when the index is out of range.
+func (p *addContentFunc[T]) ReplaceContentAt(index int, content ...any) *T {
+	if index >= 0 && index < len(p.el.content) {
+		p.el.content = slices.Delete(p.el.content, index, index+1)
+		p.el.insertContent(index, content...)
+	}
+	return p.t
+}
+
+// ClearContent removes all the content of the current element.
+func (p *addContentFunc[T]) ClearContent() *T {
+	p.el.content = nil
+	return p.t
+}
+
 // #region ELEMENT
 // An "element" is a basic HTML element that can have attributes, classes,
 // styles, and content. It can be a void element (without a closing tag) or a
@@ -41,127 +117,391 @@ type element struct {
 	// https://developer.mozilla.org/en-US/docs/Learn_web_development/Core/Structuring_content/Basic_HTML_syntax#void_elements
 	hasClosingTag bool
 
-	attributes []string
+	attributes []attr
 	classes    []string
 	styles     []string
 	content    []fmt.Stringer
+
+	// foreign elements, of SVG or MathML, are written with a self-closing tag
+	// when they have no content.
+	foreign bool
+
+	// errs are the errors found while building the element in Strict mode.
+	errs []error
 }
 
 // String returns HTML text of the current element.
-// func (p *element) String() string {
-// 	var s strings.Builder
-// 	if p.tag == "" {
-// 		s.WriteString(p.getContent())
-// 		return s.String()
-// 	}
-
-// 	s.WriteString("<")
-// 	s.WriteString(p.tag)
-// 	s.WriteString(p.getAttributes())
-// 	s.WriteString(p.getClasses())
-// 	s.WriteString(p.getStyles())
-
-// 	if p.hasClosingTag {
-// 		s.WriteString(">")
-// 		s.WriteString(p.getContent())
-// 		s.WriteString("</")
-// 		s.WriteString(p.tag)
-// 		s.WriteString(">")
-// 	} else {
-// 		s.WriteString("/>")
-// 	}
-
-// 	return s.String()
-// }
-
 func (p *element) String() string {
-	if p.tag == "" {
-		// it is only used for UntaggedElement
-		return fmt.Sprintf("%v", p.getContent())
+	return renderString(p)
+}
+
+// attr is an attribute of an element. Its value is escaped when the element
+// is rendered.
+type attr struct {
+	name  string
+	value string
+
+	// hasValue is false for attributes rendered without a value, like
+	// "disabled".
+	hasValue bool
+}
+
+// addAttribute adds an attribute to the element. When the element already has
+// it, the value is replaced, keeping its position, and the duplicate is
+// reported in Strict mode. The class and style attributes are merged with the
+// classes and styles of the element.
+func (p *element) addAttribute(name string, value ...any) {
+	p.putAttribute(name, true, value...)
+}
+
+// setAttribute sets the value of an attribute, replacing the previous value
+// if the attribute was already added.
+func (p *element) setAttribute(name string, value ...any) {
+	p.putAttribute(name, false, value...)
+}
+
+func (p *element) putAttribute(name string, reportDuplicate bool, value ...any) {
+	name = strings.TrimSpace(name)
+	if !validAttributeName(name) {
+		if Strict {
+			p.addError(fmt.Errorf("%w name %q", ErrInvalidAttribute, name))
+		}
+		return
 	}
 
-	if p.hasClosingTag {
-		return fmt.Sprintf("<%[1]v%v%v%v>%v</%[1]v>", p.tag, p.getAttributes(), p.getClasses(), p.getStyles(), p.getContent())
+	a := attr{name: name}
+	if value != nil {
+		a.value = fmt.Sprintf("%v", value[0])
+		a.hasValue = true
+	}
+
+	switch strings.ToLower(name) {
+	case "class":
+		p.addClasses(a.value)
+		return
+	case "style":
+		p.addStyles(a.value)
+		return
 	}
 
-	return fmt.Sprintf("<%[1]v%v%v%v/>", p.tag, p.getAttributes(), p.getClasses(), p.getStyles())
+	i := p.attributeIndex(name)
+	if i < 0 {
+		p.attributes = append(p.attributes, a)
+		return
+	}
+
+	if reportDuplicate && Strict {
+		p.addError(fmt.Errorf("%w %q", ErrDuplicateAttribute, name))
+	}
+	p.attributes[i] = a
 }
 
-func (p *element) addAttribute(attr string, value ...any) {
-	attr = strings.TrimSpace(attr)
-	if attr == "" {
+// addBoolean sets a boolean attribute, like disabled or required. When it is
+// true, or value is omitted, the attribute is written without a value. When
+// it is false, the attribute is removed, as any value (even "false") would
+// enable it.
+func (p *element) addBoolean(name string, value ...bool) {
+	if len(value) > 0 && !value[0] {
+		p.removeAttribute(name)
 		return
 	}
-	if value != nil {
-		attr = fmt.Sprintf(`%v="%v"`, attr, value[0])
+	p.addAttribute(name)
+}
+
+// addKeyword sets an enumerated attribute, like loading or dir. The value is
+// always written as given; in Strict mode, a value that is not one of the
+// keywords, compared ignoring case, is recorded as ErrInvalidValue.
+func addKeyword[V ~string](p *element, name string, value V, keywords ...V) {
+	p.addAttribute(name, string(value))
+	if Strict && !isKeyword(value, keywords) {
+		p.addError(fmt.Errorf("%w %q for attribute %q", ErrInvalidValue, value, name))
+	}
+}
+
+// addKeywords sets an attribute whose value is a set of keywords separated
+// by spaces, like rel or sandbox. In Strict mode, each value that is not one
+// of the keywords is recorded as ErrInvalidValue.
+func addKeywords[V ~string](p *element, name string, values []V, keywords ...V) {
+	list := make([]string, 0, len(values))
+	for _, v := range values {
+		list = append(list, strings.Fields(string(v))...)
 	}
+	p.addAttribute(name, strings.Join(list, " "))
 
-	p.attributes = append(p.attributes, attr)
+	if !Strict {
+		return
+	}
+	for _, v := range list {
+		if !isKeyword(V(v), keywords) {
+			p.addError(fmt.Errorf("%w %q for attribute %q", ErrInvalidValue, v, name))
+		}
+	}
+}
+
+func isKeyword[V ~string](value V, keywords []V) bool {
+	return slices.ContainsFunc(keywords, func(k V) bool {
+		return strings.EqualFold(string(k), string(value))
+	})
 }
 
+// attributeIndex returns the position of the attribute called name, or -1.
+// Attribute names are case-insensitive.
+func (p *element) attributeIndex(name string) int {
+	return slices.IndexFunc(p.attributes, func(a attr) bool {
+		return strings.EqualFold(a.name, name)
+	})
+}
+
+// addClasses adds the classes that the element doesn't have yet. A string
+// can hold several classes separated by spaces.
 func (p *element) addClasses(class ...string) {
 	for _, c := range class {
-		c = strings.TrimSpace(c)
-		if c != "" {
-			p.classes = append(p.classes, c)
+		for _, f := range strings.Fields(c) {
+			if !slices.Contains(p.classes, f) {
+				p.classes = append(p.classes, f)
+			}
 		}
 	}
 }
 
+// addStyles adds the CSS declarations. A string can hold several
+// declarations separated by semicolons. A declaration of a property that the
+// element already has replaces the previous one, keeping its position.
+//
+// In Strict mode, a declaration that is not written as "property: value" is
+// recorded as ErrInvalidValue. It is added anyway, as the CSS of the browser
+// ignores it.
 func (p *element) addStyles(style ...string) {
 	for _, s := range style {
-		s = strings.TrimSpace(s)
-		if s != "" && s[len(s)-1] != ';' {
-			s += ";"
+		for _, decl := range splitDeclarations(s) {
+			decl = strings.TrimSpace(decl)
+			if Strict && !validDeclaration(decl) {
+				p.addError(fmt.Errorf("%w %q for attribute %q", ErrInvalidValue, decl, "style"))
+			}
+			decl += ";"
+
+			property := styleProperty(decl)
+			i := slices.IndexFunc(p.styles, func(s string) bool {
+				return styleProperty(s) == property
+			})
+			if i < 0 {
+				p.styles = append(p.styles, decl)
+			} else {
+				p.styles[i] = decl
+			}
 		}
+	}
+}
 
-		p.styles = append(p.styles, s)
+// validDeclaration reports whether the CSS declaration has a property name
+// and a value separated by a colon.
+func validDeclaration(decl string) bool {
+	property, value, ok := strings.Cut(decl, ":")
+	property = strings.TrimSpace(property)
+	return ok && property != "" && !strings.ContainsAny(property, " \t\n\r\f\"'(") && strings.TrimSpace(value) != ""
+}
+
+// splitDeclarations splits CSS declarations separated by semicolons. The
+// semicolons inside quotes or parentheses, like in url("data:..."), don't
+// split them.
+func splitDeclarations(s string) []string {
+	var list []string
+	var quote byte
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ';' && depth == 0:
+			list = append(list, s[start:i])
+			start = i + 1
+		}
 	}
+	list = append(list, s[start:])
+
+	return slices.DeleteFunc(list, func(decl string) bool {
+		return strings.TrimSpace(decl) == ""
+	})
+}
+
+// styleProperty returns the lower case property name of a CSS declaration.
+func styleProperty(decl string) string {
+	name, _, _ := strings.Cut(decl, ":")
+	return strings.ToLower(strings.TrimSpace(name))
 }
 
 func (p *element) addContent(content ...any) {
+	p.content = append(p.content, p.contentOf(content...)...)
+}
+
+// insertContent adds content at the position index, which is clamped to the
+// bounds of the current content.
+func (p *element) insertContent(index int, content ...any) {
+	index = max(0, min(index, len(p.content)))
+	p.content = slices.Insert(p.content, index, p.contentOf(content...)...)
+}
+
+// contentOf converts the values received by AddContent into the content of
+// the current element.
+func (p *element) contentOf(content ...any) []fmt.Stringer {
+	list := make([]fmt.Stringer, 0, len(content))
 	for _, value := range content {
 		switch v := value.(type) {
+		case Component:
+			if !isNil(v) {
+				list = append(list, Use(v))
+			}
 		case fmt.Stringer:
-			p.content = append(p.content, v)
+			list = append(list, v)
 		case string:
-			p.content = append(p.content, &rawStringEntity{content: v})
+			list = append(list, p.text(v))
 		case nil:
 			continue
 		default:
-			p.content = append(p.content, rawString("%v", v))
+			list = append(list, p.text(fmt.Sprintf("%v", v)))
 		}
 	}
+	return list
 }
 
-func (p *element) getAttributes() string {
-	if len(p.attributes) == 0 {
-		return ""
+// isNil reports whether v is nil, including a nil pointer or function stored
+// in the interface, like a (*DivElement)(nil) returned by a view or a nil
+// ComponentFunc.
+func isNil(v any) bool {
+	if v == nil {
+		return true
 	}
-	return fmt.Sprintf(` %v`, strings.Join(p.attributes, " "))
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Func, reflect.Map, reflect.Slice, reflect.Interface, reflect.Chan:
+		return rv.IsNil()
+	}
+	return false
 }
 
-func (p *element) getClasses() string {
-	if len(p.classes) == 0 {
-		return ""
+// sameNode reports whether a and b are the same child. The values that can't
+// be compared, like a slice implementing fmt.Stringer, are never the same.
+func sameNode(a, b fmt.Stringer) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.ValueOf(a).Comparable() || !reflect.ValueOf(b).Comparable() {
+		return false
 	}
-	return fmt.Sprintf(` class="%v"`, strings.Join(p.classes, " "))
+	return a == b
+}
+
+// removeAttribute removes all the attributes called name. The class and
+// style attributes remove all the classes or styles.
+func (p *element) removeAttribute(name string) {
+	name = strings.TrimSpace(name)
+	switch strings.ToLower(name) {
+	case "class":
+		p.classes = nil
+	case "style":
+		p.styles = nil
+	}
+
+	p.attributes = slices.DeleteFunc(p.attributes, func(a attr) bool {
+		return strings.EqualFold(a.name, name)
+	})
+}
+
+// removeClasses removes the classes from the element.
+func (p *element) removeClasses(class ...string) {
+	p.classes = slices.DeleteFunc(p.classes, func(c string) bool {
+		return slices.Contains(class, c)
+	})
 }
 
-func (p *element) getStyles() string {
-	if len(p.styles) == 0 {
-		return ""
+// removeStyle removes the declarations of the CSS property.
+func (p *element) removeStyle(property string) {
+	property = strings.ToLower(strings.TrimSpace(property))
+	p.styles = slices.DeleteFunc(p.styles, func(s string) bool {
+		return styleProperty(s) == property
+	})
+}
+
+// text returns a plain text for the content of the current element. It is
+// escaped according to the element unless AutoEscape is disabled.
+func (p *element) text(s string) fmt.Stringer {
+	if !AutoEscape {
+		return &rawStringEntity{content: s}
+	}
+
+	switch p.tag {
+	case "script":
+		return &textEntity{context: scriptContext, content: s}
+	case "style":
+		return &textEntity{context: styleContext, content: s}
+	default:
+		return &textEntity{context: textContext, content: s}
 	}
-	return fmt.Sprintf(` style="%v"`, strings.Join(p.styles, " "))
 }
 
-func (p *element) getContent() string {
-	var s strings.Builder
-	for _, el := range p.content {
-		s.WriteString(el.String())
+// elem returns the element itself. As all the element types embed *element,
+// it gives access to the element behind any of them.
+func (p *element) elem() *element {
+	return p
+}
+
+// elementer is implemented by all the element types.
+type elementer interface {
+	elem() *element
+}
+
+// #region INSPECTION
+
+// Attribute is an attribute of an element, as returned by Attributes.
+type Attribute struct {
+	Name  string
+	Value string
+
+	// HasValue is false for the attributes written without a value, like the
+	// boolean attribute "hidden".
+	HasValue bool
+}
+
+// Tag returns the tag name of the element. It is empty for the elements
+// without tags, like Container.
+func (p *element) Tag() string {
+	return p.tag
+}
+
+// Attributes returns the attributes of the element in the order they were
+// added, except the class and style attributes, which are returned by
+// Classes and Styles.
+func (p *element) Attributes() []Attribute {
+	attrs := make([]Attribute, len(p.attributes))
+	for i, a := range p.attributes {
+		attrs[i] = Attribute{Name: a.name, Value: a.value, HasValue: a.hasValue}
 	}
+	return attrs
+}
 
-	return s.String()
+// Classes returns the classes of the element.
+func (p *element) Classes() []string {
+	return slices.Clone(p.classes)
+}
+
+// Styles returns the CSS declarations of the element.
+func (p *element) Styles() []string {
+	return slices.Clone(p.styles)
+}
+
+// Children returns the content of the element: elements, texts and any other
+// fmt.Stringer added to it. Changing the returned slice doesn't change the
+// element.
+func (p *element) Children() []fmt.Stringer {
+	return slices.Clone(p.content)
 }
 
 func newElement(tag string, hasClosingTag bool, content ...any) *element {
@@ -195,22 +535,6 @@ func Container(content ...any) *UntaggedElement {
 	return el
 }
 
-// #region Component
-
-// The use of this method is to create server-side web components to group
-// different HTML elements.
-//
-// Note: It is not an official HTML element.
-func Component(content ...any) *UntaggedElement {
-	ne := newElement("", false, content...)
-
-	var ac = new(addContentFunc[UntaggedElement])
-	var el = &UntaggedElement{ne, ac}
-	ac.set(ne, el)
-
-	return el
-}
-
 // #region MAIN ROOT
 // The main and only element in an html document is precisely the
 // <html> element.
@@ -222,12 +546,72 @@ func Component(content ...any) *UntaggedElement {
 type HtmlElement struct {
 	*element
 	*attrGlobal[HtmlElement]
+	*attrExternalAttributes[HtmlElement]
+	*attrOn[HtmlElement]
 	*addContentFunc[HtmlElement]
 }
 
 // String returns HTML text of the current element.
 func (p *HtmlElement) String() string {
-	return fmt.Sprintf("<!DOCTYPE html><%[1]v%v%v%v>%v</%[1]v>", p.tag, p.getAttributes(), p.getClasses(), p.getStyles(), p.getContent())
+	return renderString(p)
+}
+
+// Render writes the HTML text of the document, including its doctype, to w.
+func (p *HtmlElement) Render(w io.Writer) error {
+	r := newRenderer(w)
+	p.render(r)
+	return r.err
+}
+
+// WriteTo writes the HTML text of the document, including its doctype, to w.
+// It implements io.WriterTo.
+func (p *HtmlElement) WriteTo(w io.Writer) (int64, error) {
+	r := newRenderer(w)
+	p.render(r)
+	return r.n, r.err
+}
+
+// RenderContext writes the HTML text of the document, including its doctype,
+// to w, giving ctx to the components it contains.
+func (p *HtmlElement) RenderContext(ctx context.Context, w io.Writer) error {
+	r := newRenderer(w)
+	r.ctx = ctx
+	p.render(r)
+	return r.err
+}
+
+// RenderStrict writes the HTML text of the document, including its doctype,
+// to w, only when it has no errors, like element.RenderStrict does.
+func (p *HtmlElement) RenderStrict(w io.Writer) error {
+	if errs := p.Errors(); errs != nil {
+		return errors.Join(errs...)
+	}
+	return p.Render(w)
+}
+
+// RenderIndent writes the HTML text of the document, including its doctype,
+// to w, indented like element.RenderIndent does.
+func (p *HtmlElement) RenderIndent(w io.Writer, indent string) error {
+	r := newRenderer(w)
+	r.indent = indent
+	p.render(r)
+	return r.err
+}
+
+// StringIndent returns the HTML text of the document, including its doctype,
+// indented like element.RenderIndent does.
+func (p *HtmlElement) StringIndent(indent string) string {
+	var s strings.Builder
+	p.RenderIndent(&s, indent)
+	return s.String()
+}
+
+func (p *HtmlElement) render(r *renderer) {
+	r.writeString("<!DOCTYPE html>")
+	if r.indent != "" {
+		r.newLine()
+	}
+	p.element.render(r)
 }
 
 // Html represents the root (top-level element) of an HTML document, so it is
@@ -241,10 +625,14 @@ func Html(content ...any) *HtmlElement {
 	ne := newElement("html", true, content...)
 
 	var ga = new(attrGlobal[HtmlElement])
+	var ea = new(attrExternalAttributes[HtmlElement])
+	var on = new(attrOn[HtmlElement])
 	var ac = new(addContentFunc[HtmlElement])
-	var el = &HtmlElement{ne, ga, ac}
-	ac.set(ne, el)
+	var el = &HtmlElement{ne, ga, ea, on, ac}
 	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
 
 	return el
 }
@@ -262,6 +650,10 @@ type BaseElement struct {
 	*element
 	*attrHref[BaseElement]
 	*attrTarget[BaseElement]
+
+	*attrGlobal[BaseElement]
+	*attrExternalAttributes[BaseElement]
+	*attrOn[BaseElement]
 }
 
 // Base specifies the base URL to use for all relative URLs in a document.
@@ -280,9 +672,15 @@ func Base() *BaseElement {
 
 	var a = new(attrHref[BaseElement])
 	var b = new(attrTarget[BaseElement])
-	var el = &BaseElement{ne, a, b}
+	var ga = new(attrGlobal[BaseElement])
+	var ea = new(attrExternalAttributes[BaseElement])
+	var on = new(attrOn[BaseElement])
+	var el = &BaseElement{ne, a, b, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
 
 	return el
 }
@@ -292,6 +690,9 @@ func Base() *BaseElement {
 // HeadElement represents the <head> element.
 type HeadElement struct {
 	*element
+	*attrGlobal[HeadElement]
+	*attrExternalAttributes[HeadElement]
+	*attrOn[HeadElement]
 	*addContentFunc[HeadElement]
 }
 
@@ -304,8 +705,14 @@ type HeadElement struct {
 func Head(content ...any) *HeadElement {
 	ne := newElement("head", true, content...)
 
+	var ga = new(attrGlobal[HeadElement])
+	var ea = new(attrExternalAttributes[HeadElement])
+	var on = new(attrOn[HeadElement])
 	var ac = new(addContentFunc[HeadElement])
-	var el = &HeadElement{ne, ac}
+	var el = &HeadElement{ne, ga, ea, on, ac}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
 	ac.set(ne, el)
 
 	return el
@@ -317,15 +724,25 @@ func Head(content ...any) *HeadElement {
 type LinkElement struct {
 	*element
 	*attrAs[LinkElement]
+	*attrBlocking[LinkElement]
+	*attrColor[LinkElement]
 	*attrCrossOrigin[LinkElement]
 	*attrDisabled[LinkElement]
+	*attrFetchPriority[LinkElement]
 	*attrHref[LinkElement]
 	*attrHrefLang[LinkElement]
+	*attrImageSizes[LinkElement]
+	*attrImageSrcSet[LinkElement]
 	*attrIntegrity[LinkElement]
 	*attrMedia[LinkElement]
+	*attrReferrerPolicy[LinkElement]
 	*attrRel[LinkElement]
 	*attrSizes[LinkElement]
 	*attrType[LinkElement]
+
+	*attrGlobal[LinkElement]
+	*attrExternalAttributes[LinkElement]
+	*attrOn[LinkElement]
 }
 
 // Link specifies relationships between the current document and an external
@@ -342,18 +759,25 @@ func Link() *LinkElement {
 	ne := newElement("link", false)
 
 	var a = new(attrAs[LinkElement])
-	var b = new(attrCrossOrigin[LinkElement])
-	var c = new(attrDisabled[LinkElement])
-	var d = new(attrHref[LinkElement])
-	var e = new(attrHrefLang[LinkElement])
-	var f = new(attrIntegrity[LinkElement])
-	var g = new(attrMedia[LinkElement])
-	var h = new(attrRel[LinkElement])
-	var i = new(attrSizes[LinkElement])
-	var j = new(attrType[LinkElement])
-
-	var el = &LinkElement{ne, a, b, c, d, e, f, g, h, i, j}
-
+	var b = new(attrBlocking[LinkElement])
+	var c = new(attrColor[LinkElement])
+	var d = new(attrCrossOrigin[LinkElement])
+	var e = new(attrDisabled[LinkElement])
+	var f = new(attrFetchPriority[LinkElement])
+	var g = new(attrHref[LinkElement])
+	var h = new(attrHrefLang[LinkElement])
+	var i = new(attrImageSizes[LinkElement])
+	var j = new(attrImageSrcSet[LinkElement])
+	var k = new(attrIntegrity[LinkElement])
+	var l = new(attrMedia[LinkElement])
+	var m = new(attrReferrerPolicy[LinkElement])
+	var n = new(attrRel[LinkElement])
+	var o = new(attrSizes[LinkElement])
+	var p = new(attrType[LinkElement])
+	var ga = new(attrGlobal[LinkElement])
+	var ea = new(attrExternalAttributes[LinkElement])
+	var on = new(attrOn[LinkElement])
+	var el = &LinkElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
@@ -364,6 +788,15 @@ func Link() *LinkElement {
 	h.set(ne, el)
 	i.set(ne, el)
 	j.set(ne, el)
+	k.set(ne, el)
+	l.set(ne, el)
+	m.set(ne, el)
+	n.set(ne, el)
+	o.set(ne, el)
+	p.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
 
 	return el
 }
@@ -374,9 +807,14 @@ func Link() *LinkElement {
 type MetaElement struct {
 	*element
 	*attrCharSet[MetaElement]
-	*attrMetaContent[MetaElement]
 	*attrHttpEquiv[MetaElement]
+	*attrMedia[MetaElement]
+	*attrMetaContent[MetaElement]
 	*attrName[MetaElement]
+
+	*attrGlobal[MetaElement]
+	*attrExternalAttributes[MetaElement]
+	*attrOn[MetaElement]
 }
 
 // Meta represents metadata that cannot be represented by other HTML
@@ -389,14 +827,22 @@ func Meta() *MetaElement {
 	ne := newElement("meta", false)
 
 	var a = new(attrCharSet[MetaElement])
-	var b = new(attrMetaContent[MetaElement])
-	var c = new(attrHttpEquiv[MetaElement])
-	var d = new(attrName[MetaElement])
-	var el = &MetaElement{ne, a, b, c, d}
+	var b = new(attrHttpEquiv[MetaElement])
+	var c = new(attrMedia[MetaElement])
+	var d = new(attrMetaContent[MetaElement])
+	var e = new(attrName[MetaElement])
+	var ga = new(attrGlobal[MetaElement])
+	var ea = new(attrExternalAttributes[MetaElement])
+	var on = new(attrOn[MetaElement])
+	var el = &MetaElement{ne, a, b, c, d, e, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
+	e.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
 
 	return el
 }
@@ -406,8 +852,13 @@ func Meta() *MetaElement {
 // StyleElement represents the <style> element.
 type StyleElement struct {
 	*element
+	*attrBlocking[StyleElement]
 	*attrMedia[StyleElement]
 	*attrType[StyleElement]
+
+	*attrGlobal[StyleElement]
+	*attrExternalAttributes[StyleElement]
+	*attrOn[StyleElement]
 	*addContentFunc[StyleElement]
 }
 
@@ -421,12 +872,20 @@ type StyleElement struct {
 func Style(content ...any) *StyleElement {
 	ne := newElement("style", true, content...)
 
-	var a = new(attrMedia[StyleElement])
-	var b = new(attrType[StyleElement])
+	var a = new(attrBlocking[StyleElement])
+	var b = new(attrMedia[StyleElement])
+	var c = new(attrType[StyleElement])
+	var ga = new(attrGlobal[StyleElement])
+	var ea = new(attrExternalAttributes[StyleElement])
+	var on = new(attrOn[StyleElement])
 	var ac = new(addContentFunc[StyleElement])
-	var el = &StyleElement{ne, a, b, ac}
+	var el = &StyleElement{ne, a, b, c, ga, ea, on, ac}
 	a.set(ne, el)
 	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
 	ac.set(ne, el)
 
 	return el
@@ -437,6 +896,9 @@ func Style(content ...any) *StyleElement {
 // TitleElement represents the <title> element.
 type TitleElement struct {
 	*element
+	*attrGlobal[TitleElement]
+	*attrExternalAttributes[TitleElement]
+	*attrOn[TitleElement]
 	*addContentFunc[TitleElement]
 }
 
@@ -449,8 +911,14 @@ type TitleElement struct {
 func Title(content ...any) *TitleElement {
 	ne := newElement("title", true, content...)
 
+	var ga = new(attrGlobal[TitleElement])
+	var ea = new(attrExternalAttributes[TitleElement])
+	var on = new(attrOn[TitleElement])
 	var ac = new(addContentFunc[TitleElement])
-	var el = &TitleElement{ne, ac}
+	var el = &TitleElement{ne, ga, ea, on, ac}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
 	ac.set(ne, el)
 
 	return el
@@ -1466,6 +1934,23 @@ type OlElement struct {
 	*addContentFunc[OlElement]
 }
 
+// OlType is a numbering type of an ordered list, the value of its type
+// attribute. Unlike other keywords, its values are case-sensitive.
+type OlType string
+
+const (
+	// OlTypeDecimal numbers the items with numbers (default).
+	OlTypeDecimal OlType = "1"
+	// OlTypeLowerAlpha numbers the items with lowercase letters.
+	OlTypeLowerAlpha OlType = "a"
+	// OlTypeUpperAlpha numbers the items with uppercase letters.
+	OlTypeUpperAlpha OlType = "A"
+	// OlTypeLowerRoman numbers the items with lowercase Roman numerals.
+	OlTypeLowerRoman OlType = "i"
+	// OlTypeUpperRoman numbers the items with uppercase Roman numerals.
+	OlTypeUpperRoman OlType = "I"
+)
+
 // Type sets the numbering type:
 //   - a for lowercase letters.
 //   - A for uppercase letters.
@@ -1475,8 +1960,8 @@ type OlElement struct {
 //
 // The specified type is used for the entire list unless a different type
 // attribute is used on an enclosed <li> element.
-func (p *OlElement) Type(value string) *OlElement {
-	p.addAttribute("type", value)
+func (p *OlElement) Type(value OlType) *OlElement {
+	addKeyword(p.element, "type", value, OlTypeDecimal, OlTypeLowerAlpha, OlTypeUpperAlpha, OlTypeLowerRoman, OlTypeUpperRoman)
 	return p
 }
 
@@ -1651,6 +2136,7 @@ type AElement struct {
 	*attrHref[AElement]
 	*attrHrefLang[AElement]
 	*attrPing[AElement]
+	*attrReferrerPolicy[AElement]
 	*attrRel[AElement]
 	*attrTarget[AElement]
 	*attrType[AElement]
@@ -1671,10 +2157,11 @@ type AElement struct {
 func A(content ...any) *AElement {
 	ne := newElement("a", true, content...)
 
-	var b = new(attrDownload[AElement])
-	var c = new(attrHref[AElement])
-	var d = new(attrHrefLang[AElement])
-	var e = new(attrPing[AElement])
+	var a = new(attrDownload[AElement])
+	var b = new(attrHref[AElement])
+	var c = new(attrHrefLang[AElement])
+	var d = new(attrPing[AElement])
+	var e = new(attrReferrerPolicy[AElement])
 	var f = new(attrRel[AElement])
 	var g = new(attrTarget[AElement])
 	var h = new(attrType[AElement])
@@ -1682,7 +2169,8 @@ func A(content ...any) *AElement {
 	var ea = new(attrExternalAttributes[AElement])
 	var on = new(attrOn[AElement])
 	var ac = new(addContentFunc[AElement])
-	var el = &AElement{ne, b, c, d, e, f, g, h, ga, ea, on, ac}
+	var el = &AElement{ne, a, b, c, d, e, f, g, h, ga, ea, on, ac}
+	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
@@ -2282,6 +2770,45 @@ func Rt(content ...any) *RtElement {
 	return el
 }
 
+// #region <ruby>
+
+// RubyElement represents the <ruby> element.
+type RubyElement struct {
+	*element
+	*attrGlobal[RubyElement]
+	*attrExternalAttributes[RubyElement]
+	*attrOn[RubyElement]
+	*addContentFunc[RubyElement]
+}
+
+// Ruby represents small annotations that are rendered above, below, or next
+// to base text, usually used for showing the pronunciation of East Asian
+// characters. The annotations are given by <rt> elements, optionally wrapped
+// in <rp> parentheses for the browsers that don't support ruby.
+//
+// Example:
+//
+//	Ruby("漢", Rp("("), Rt("kan"), Rp(")"), "字", Rp("("), Rt("ji"), Rp(")"))
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/ruby
+func Ruby(content ...any) *RubyElement {
+	ne := newElement("ruby", true, content...)
+
+	var ga = new(attrGlobal[RubyElement])
+	var ea = new(attrExternalAttributes[RubyElement])
+	var on = new(attrOn[RubyElement])
+	var ac = new(addContentFunc[RubyElement])
+	var el = &RubyElement{ne, ga, ea, on, ac}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
 // #region <s>
 
 // SElement represents the <s> element.
@@ -2676,6 +3203,7 @@ type AreaElement struct {
 	*attrDownload[AreaElement]
 	*attrHref[AreaElement]
 	*attrPing[AreaElement]
+	*attrReferrerPolicy[AreaElement]
 	*attrRel[AreaElement]
 	*attrShape[AreaElement]
 	*attrTarget[AreaElement]
@@ -2700,13 +3228,14 @@ func Area() *AreaElement {
 	var c = new(attrDownload[AreaElement])
 	var d = new(attrHref[AreaElement])
 	var e = new(attrPing[AreaElement])
-	var f = new(attrRel[AreaElement])
-	var g = new(attrShape[AreaElement])
-	var h = new(attrTarget[AreaElement])
+	var f = new(attrReferrerPolicy[AreaElement])
+	var g = new(attrRel[AreaElement])
+	var h = new(attrShape[AreaElement])
+	var i = new(attrTarget[AreaElement])
 	var ga = new(attrGlobal[AreaElement])
 	var ea = new(attrExternalAttributes[AreaElement])
 	var on = new(attrOn[AreaElement])
-	var el = &AreaElement{ne, a, b, c, d, e, f, g, h, ga, ea, on}
+	var el = &AreaElement{ne, a, b, c, d, e, f, g, h, i, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
@@ -2715,6 +3244,7 @@ func Area() *AreaElement {
 	f.set(ne, el)
 	g.set(ne, el)
 	h.set(ne, el)
+	i.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -2722,6 +3252,76 @@ func Area() *AreaElement {
 	return el
 }
 
+// #region <audio>
+
+// AudioElement represents the <audio> element.
+type AudioElement struct {
+	*element
+	*attrAutoPlay[AudioElement]
+	*attrControls[AudioElement]
+	*attrControlsList[AudioElement]
+	*attrCrossOrigin[AudioElement]
+	*attrDisableRemotePlayBack[AudioElement]
+	*attrLoop[AudioElement]
+	*attrMuted[AudioElement]
+	*attrPreLoad[AudioElement]
+	*attrSrc[AudioElement]
+
+	*attrGlobal[AudioElement]
+	*attrExternalAttributes[AudioElement]
+	*attrOn[AudioElement]
+	*addContentFunc[AudioElement]
+}
+
+// Audio embeds sound content in documents. It may contain one or more audio
+// sources, given by Src or by <source> elements, and <track> elements. Its
+// other content is shown by browsers that don't support the element.
+//
+// Example:
+//
+//	Audio(
+//		Source().Src("/podcast.ogg").Type("audio/ogg"),
+//		Source().Src("/podcast.mp3").Type("audio/mpeg"),
+//		A("Download the episode").Href("/podcast.mp3"),
+//	).Controls().PreLoad(PreLoadMetadata)
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/audio
+func Audio(content ...any) *AudioElement {
+	ne := newElement("audio", true, content...)
+
+	var a = new(attrAutoPlay[AudioElement])
+	var b = new(attrControls[AudioElement])
+	var c = new(attrControlsList[AudioElement])
+	var d = new(attrCrossOrigin[AudioElement])
+	var e = new(attrDisableRemotePlayBack[AudioElement])
+	var f = new(attrLoop[AudioElement])
+	var g = new(attrMuted[AudioElement])
+	var h = new(attrPreLoad[AudioElement])
+	var i = new(attrSrc[AudioElement])
+	var ga = new(attrGlobal[AudioElement])
+	var ea = new(attrExternalAttributes[AudioElement])
+	var on = new(attrOn[AudioElement])
+	var ac = new(addContentFunc[AudioElement])
+	var el = &AudioElement{ne, a, b, c, d, e, f, g, h, i, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	d.set(ne, el)
+	e.set(ne, el)
+	f.set(ne, el)
+	g.set(ne, el)
+	h.set(ne, el)
+	i.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
 // #region <img>
 
 // ImgElement represents the <img> element.
@@ -2730,10 +3330,16 @@ type ImgElement struct {
 	*attrAlt[ImgElement]
 	*attrCrossOrigin[ImgElement]
 	*attrDecoding[ImgElement]
+	*attrFetchPriority[ImgElement]
+	*attrHeight[ImgElement]
 	*attrIsmap[ImgElement]
 	*attrLoading[ImgElement]
+	*attrReferrerPolicy[ImgElement]
+	*attrSizes[ImgElement]
 	*attrSrc[ImgElement]
 	*attrSrcSet[ImgElement]
+	*attrUseMap[ImgElement]
+	*attrWidth[ImgElement]
 
 	*attrGlobal[ImgElement]
 	*attrExternalAttributes[ImgElement]
@@ -2751,14 +3357,20 @@ func Img() *ImgElement {
 	var a = new(attrAlt[ImgElement])
 	var b = new(attrCrossOrigin[ImgElement])
 	var c = new(attrDecoding[ImgElement])
-	var d = new(attrIsmap[ImgElement])
-	var e = new(attrLoading[ImgElement])
-	var f = new(attrSrc[ImgElement])
-	var g = new(attrSrcSet[ImgElement])
+	var d = new(attrFetchPriority[ImgElement])
+	var e = new(attrHeight[ImgElement])
+	var f = new(attrIsmap[ImgElement])
+	var g = new(attrLoading[ImgElement])
+	var h = new(attrReferrerPolicy[ImgElement])
+	var i = new(attrSizes[ImgElement])
+	var j = new(attrSrc[ImgElement])
+	var k = new(attrSrcSet[ImgElement])
+	var l = new(attrUseMap[ImgElement])
+	var m = new(attrWidth[ImgElement])
 	var ga = new(attrGlobal[ImgElement])
 	var ea = new(attrExternalAttributes[ImgElement])
 	var on = new(attrOn[ImgElement])
-	var el = &ImgElement{ne, a, b, c, d, e, f, g, ga, ea, on}
+	var el = &ImgElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, m, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
@@ -2766,6 +3378,12 @@ func Img() *ImgElement {
 	e.set(ne, el)
 	f.set(ne, el)
 	g.set(ne, el)
+	h.set(ne, el)
+	i.set(ne, el)
+	j.set(ne, el)
+	k.set(ne, el)
+	l.set(ne, el)
+	m.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -2867,12 +3485,14 @@ type VideoElement struct {
 	*attrCrossOrigin[VideoElement]
 	*attrDisablePictureInPicture[VideoElement]
 	*attrDisableRemotePlayBack[VideoElement]
+	*attrHeight[VideoElement]
 	*attrLoop[VideoElement]
 	*attrMuted[VideoElement]
 	*attrPlaysInLine[VideoElement]
 	*attrPoster[VideoElement]
 	*attrPreLoad[VideoElement]
 	*attrSrc[VideoElement]
+	*attrWidth[VideoElement]
 
 	*attrGlobal[VideoElement]
 	*attrExternalAttributes[VideoElement]
@@ -2896,17 +3516,19 @@ func Video(content ...any) *VideoElement {
 	var d = new(attrCrossOrigin[VideoElement])
 	var e = new(attrDisablePictureInPicture[VideoElement])
 	var f = new(attrDisableRemotePlayBack[VideoElement])
-	var g = new(attrLoop[VideoElement])
-	var h = new(attrMuted[VideoElement])
-	var i = new(attrPlaysInLine[VideoElement])
-	var j = new(attrPoster[VideoElement])
-	var k = new(attrPreLoad[VideoElement])
-	var l = new(attrSrc[VideoElement])
+	var g = new(attrHeight[VideoElement])
+	var h = new(attrLoop[VideoElement])
+	var i = new(attrMuted[VideoElement])
+	var j = new(attrPlaysInLine[VideoElement])
+	var k = new(attrPoster[VideoElement])
+	var l = new(attrPreLoad[VideoElement])
+	var m = new(attrSrc[VideoElement])
+	var n = new(attrWidth[VideoElement])
 	var ga = new(attrGlobal[VideoElement])
 	var ea = new(attrExternalAttributes[VideoElement])
 	var on = new(attrOn[VideoElement])
 	var ac = new(addContentFunc[VideoElement])
-	var el = &VideoElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, ga, ea, on, ac}
+	var el = &VideoElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, m, n, ga, ea, on, ac}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
@@ -2919,6 +3541,8 @@ func Video(content ...any) *VideoElement {
 	j.set(ne, el)
 	k.set(ne, el)
 	l.set(ne, el)
+	m.set(ne, el)
+	n.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -2937,8 +3561,10 @@ func Video(content ...any) *VideoElement {
 // EmbedElement represents the <embed> element.
 type EmbedElement struct {
 	*element
-	*attrType[EmbedElement]
+	*attrHeight[EmbedElement]
 	*attrSrc[EmbedElement]
+	*attrType[EmbedElement]
+	*attrWidth[EmbedElement]
 
 	*attrGlobal[EmbedElement]
 	*attrExternalAttributes[EmbedElement]
@@ -2955,17 +3581,63 @@ type EmbedElement struct {
 func Embed() *EmbedElement {
 	ne := newElement("embed", false)
 
-	var a = new(attrType[EmbedElement])
+	var a = new(attrHeight[EmbedElement])
 	var b = new(attrSrc[EmbedElement])
+	var c = new(attrType[EmbedElement])
+	var d = new(attrWidth[EmbedElement])
 	var ga = new(attrGlobal[EmbedElement])
 	var ea = new(attrExternalAttributes[EmbedElement])
 	var on = new(attrOn[EmbedElement])
-	var el = &EmbedElement{ne, a, b, ga, ea, on}
+	var el = &EmbedElement{ne, a, b, c, d, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
+	c.set(ne, el)
+	d.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
+
+	return el
+}
+
+// #region <fencedframe>
+
+// FencedFrameElement represents the <fencedframe> element.
+type FencedFrameElement struct {
+	*element
+	*attrAllow[FencedFrameElement]
+	*attrHeight[FencedFrameElement]
+	*attrWidth[FencedFrameElement]
+
+	*attrGlobal[FencedFrameElement]
+	*attrExternalAttributes[FencedFrameElement]
+	*attrOn[FencedFrameElement]
+}
+
+// FencedFrame embeds a page like an <iframe>, but it can't communicate with
+// the embedding page. Its content is given by script, with the config
+// property, so the element has no src attribute.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/fencedframe
+func FencedFrame() *FencedFrameElement {
+	ne := newElement("fencedframe", true)
+
+	var a = new(attrAllow[FencedFrameElement])
+	var b = new(attrHeight[FencedFrameElement])
+	var c = new(attrWidth[FencedFrameElement])
+	var ga = new(attrGlobal[FencedFrameElement])
+	var ea = new(attrExternalAttributes[FencedFrameElement])
+	var on = new(attrOn[FencedFrameElement])
+	var el = &FencedFrameElement{ne, a, b, c, ga, ea, on}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+
 	return el
 }
 
@@ -2975,10 +3647,15 @@ func Embed() *EmbedElement {
 type IframeElement struct {
 	*element
 	*attrAllow[IframeElement]
+	*attrAllowFullscreen[IframeElement]
+	*attrHeight[IframeElement]
 	*attrLoading[IframeElement]
 	*attrName[IframeElement]
+	*attrReferrerPolicy[IframeElement]
 	*attrSandbox[IframeElement]
 	*attrSrc[IframeElement]
+	*attrSrcDoc[IframeElement]
+	*attrWidth[IframeElement]
 
 	*attrGlobal[IframeElement]
 	*attrExternalAttributes[IframeElement]
@@ -2996,20 +3673,30 @@ func Iframe(content ...any) *IframeElement {
 	ne := newElement("iframe", true, content...)
 
 	var a = new(attrAllow[IframeElement])
-	var b = new(attrLoading[IframeElement])
-	var c = new(attrName[IframeElement])
-	var d = new(attrSandbox[IframeElement])
-	var e = new(attrSrc[IframeElement])
+	var b = new(attrAllowFullscreen[IframeElement])
+	var c = new(attrHeight[IframeElement])
+	var d = new(attrLoading[IframeElement])
+	var e = new(attrName[IframeElement])
+	var f = new(attrReferrerPolicy[IframeElement])
+	var g = new(attrSandbox[IframeElement])
+	var h = new(attrSrc[IframeElement])
+	var i = new(attrSrcDoc[IframeElement])
+	var j = new(attrWidth[IframeElement])
 	var ga = new(attrGlobal[IframeElement])
 	var ea = new(attrExternalAttributes[IframeElement])
 	var on = new(attrOn[IframeElement])
 	var ac = new(addContentFunc[IframeElement])
-	var el = &IframeElement{ne, a, b, c, d, e, ga, ea, on, ac}
+	var el = &IframeElement{ne, a, b, c, d, e, f, g, h, i, j, ga, ea, on, ac}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
 	e.set(ne, el)
+	f.set(ne, el)
+	g.set(ne, el)
+	h.set(ne, el)
+	i.set(ne, el)
+	j.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -3025,8 +3712,10 @@ type ObjectElement struct {
 	*element
 	*attrData[ObjectElement]
 	*attrForm[ObjectElement]
+	*attrHeight[ObjectElement]
 	*attrName[ObjectElement]
 	*attrType[ObjectElement]
+	*attrWidth[ObjectElement]
 
 	*attrGlobal[ObjectElement]
 	*attrExternalAttributes[ObjectElement]
@@ -3044,19 +3733,24 @@ func Object() *ObjectElement {
 
 	var a = new(attrData[ObjectElement])
 	var b = new(attrForm[ObjectElement])
-	var c = new(attrName[ObjectElement])
-	var d = new(attrType[ObjectElement])
+	var c = new(attrHeight[ObjectElement])
+	var d = new(attrName[ObjectElement])
+	var e = new(attrType[ObjectElement])
+	var f = new(attrWidth[ObjectElement])
 	var ga = new(attrGlobal[ObjectElement])
 	var ea = new(attrExternalAttributes[ObjectElement])
 	var on = new(attrOn[ObjectElement])
-	var el = &ObjectElement{ne, a, b, c, d, ga, ea, on}
+	var el = &ObjectElement{ne, a, b, c, d, e, f, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
+	e.set(ne, el)
+	f.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
+
 	return el
 }
 
@@ -3098,11 +3792,13 @@ func Picture(content ...any) *PictureElement {
 // SourceElement represents the <source> element.
 type SourceElement struct {
 	*element
-	*attrType[SourceElement]
+	*attrHeight[SourceElement]
+	*attrMedia[SourceElement]
+	*attrSizes[SourceElement]
 	*attrSrc[SourceElement]
 	*attrSrcSet[SourceElement]
-	*attrSizes[SourceElement]
-	*attrMedia[SourceElement]
+	*attrType[SourceElement]
+	*attrWidth[SourceElement]
 
 	*attrGlobal[SourceElement]
 	*attrExternalAttributes[SourceElement]
@@ -3124,99 +3820,34 @@ type SourceElement struct {
 func Source() *SourceElement {
 	ne := newElement("source", false)
 
-	var a = new(attrType[SourceElement])
-	var b = new(attrSrc[SourceElement])
-	var c = new(attrSrcSet[SourceElement])
-	var d = new(attrSizes[SourceElement])
-	var e = new(attrMedia[SourceElement])
+	var a = new(attrHeight[SourceElement])
+	var b = new(attrMedia[SourceElement])
+	var c = new(attrSizes[SourceElement])
+	var d = new(attrSrc[SourceElement])
+	var e = new(attrSrcSet[SourceElement])
+	var f = new(attrType[SourceElement])
+	var g = new(attrWidth[SourceElement])
 	var ga = new(attrGlobal[SourceElement])
 	var ea = new(attrExternalAttributes[SourceElement])
 	var on = new(attrOn[SourceElement])
-	var el = &SourceElement{ne, a, b, c, d, e, ga, ea, on}
+	var el = &SourceElement{ne, a, b, c, d, e, f, g, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
 	e.set(ne, el)
+	f.set(ne, el)
+	g.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
+
 	return el
 }
 
 // #region SVG AND MathML
 // You can embed SVG and MathML content directly into HTML documents, using
-// the <svg> and <math> elements.
-//
-
-// #region <svg>
-
-// SvgElement represents the <svg> element.
-type SvgElement struct {
-	*element
-	*attrGlobal[SvgElement]
-	*attrExternalAttributes[SvgElement]
-	*attrOn[SvgElement]
-	*addContentFunc[SvgElement]
-}
-
-// PreserveAspectRatio represents how the svg fragment must be deformed if it
-// is displayed with a different aspect ratio.
-//
-// Value type: (none| xMinYMin| xMidYMin| xMaxYMin| xMinYMid| xMidYMid|
-// xMaxYMid| xMinYMax| xMidYMax| xMaxYMax) (meet|slice).
-// Default value: xMidYMid meet; Animatable: yes
-func (p *SvgElement) PreserveAspectRatio(value string) *SvgElement {
-	p.addAttribute("preserveAspectRatio", value)
-	return p
-}
-
-// Viewbox is the SVG viewport coordinates for the current SVG fragment.
-//
-// Value type: <list-of-numbers> ; Default value: none; Animatable: yes
-func (p *SvgElement) Viewbox(value string) *SvgElement {
-	p.addAttribute("viewbox", value)
-	return p
-}
-
-// X is the displayed x coordinate of the svg container. No effect on outermost
-// svg elements. Value type: <length>|<percentage> ; Default value: 0;
-// Animatable: yes
-func (p *SvgElement) X(value string) *SvgElement {
-	p.addAttribute("x", value)
-	return p
-}
-
-// Y is the displayed y coordinate of the svg container. No effect on outermost
-// svg elements. Value type: <length>|<percentage> ; Default value: 0;
-// Animatable: yes
-func (p *SvgElement) Y(value string) *SvgElement {
-	p.addAttribute("y", value)
-	return p
-}
-
-// Svg is an container defining a new coordinate system and viewport. It is
-// used as the outermost element of SVG documents, but it can also be used to
-// embed an SVG fragment inside an SVG or HTML document.
-//
-// For more details, see the [documentation]
-//
-// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/svg
-func Svg(content ...any) *SvgElement {
-	ne := newElement("svg", true, content...)
-
-	var ga = new(attrGlobal[SvgElement])
-	var ea = new(attrExternalAttributes[SvgElement])
-	var on = new(attrOn[SvgElement])
-	var ac = new(addContentFunc[SvgElement])
-	var el = &SvgElement{ne, ga, ea, on, ac}
-	ga.set(ne, el)
-	ea.set(ne, el)
-	on.set(ne, el)
-	ac.set(ne, el)
-
-	return el
-}
+// the <svg> and <math> elements. The SVG elements are in svg.go.
 
 // #region SCRIPTING
 // To create dynamic content and Web applications, HTML supports the use of
@@ -3228,6 +3859,9 @@ func Svg(content ...any) *SvgElement {
 // CanvasElement represents the <canvas> element.
 type CanvasElement struct {
 	*element
+	*attrHeight[CanvasElement]
+	*attrWidth[CanvasElement]
+
 	*attrGlobal[CanvasElement]
 	*attrExternalAttributes[CanvasElement]
 	*attrOn[CanvasElement]
@@ -3243,11 +3877,15 @@ type CanvasElement struct {
 func Canvas(content ...any) *CanvasElement {
 	ne := newElement("canvas", true, content...)
 
+	var a = new(attrHeight[CanvasElement])
+	var b = new(attrWidth[CanvasElement])
 	var ga = new(attrGlobal[CanvasElement])
 	var ea = new(attrExternalAttributes[CanvasElement])
 	var on = new(attrOn[CanvasElement])
 	var ac = new(addContentFunc[CanvasElement])
-	var el = &CanvasElement{ne, ga, ea, on, ac}
+	var el = &CanvasElement{ne, a, b, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -3295,9 +3933,13 @@ func NoScript(content ...any) *NoScriptElement {
 type ScriptElement struct {
 	*element
 	*attrAsync[ScriptElement]
+	*attrBlocking[ScriptElement]
 	*attrCrossOrigin[ScriptElement]
 	*attrDefer[ScriptElement]
+	*attrFetchPriority[ScriptElement]
 	*attrIntegrity[ScriptElement]
+	*attrNoModule[ScriptElement]
+	*attrReferrerPolicy[ScriptElement]
 	*attrSrc[ScriptElement]
 	*attrType[ScriptElement]
 
@@ -3318,22 +3960,30 @@ func Script(content ...any) *ScriptElement {
 	ne := newElement("script", true, content...)
 
 	var a = new(attrAsync[ScriptElement])
-	var b = new(attrCrossOrigin[ScriptElement])
-	var c = new(attrDefer[ScriptElement])
-	var d = new(attrIntegrity[ScriptElement])
-	var e = new(attrSrc[ScriptElement])
-	var f = new(attrType[ScriptElement])
+	var b = new(attrBlocking[ScriptElement])
+	var c = new(attrCrossOrigin[ScriptElement])
+	var d = new(attrDefer[ScriptElement])
+	var e = new(attrFetchPriority[ScriptElement])
+	var f = new(attrIntegrity[ScriptElement])
+	var g = new(attrNoModule[ScriptElement])
+	var h = new(attrReferrerPolicy[ScriptElement])
+	var i = new(attrSrc[ScriptElement])
+	var j = new(attrType[ScriptElement])
 	var ga = new(attrGlobal[ScriptElement])
 	var ea = new(attrExternalAttributes[ScriptElement])
 	var on = new(attrOn[ScriptElement])
 	var ac = new(addContentFunc[ScriptElement])
-	var el = &ScriptElement{ne, a, b, c, d, e, f, ga, ea, on, ac}
+	var el = &ScriptElement{ne, a, b, c, d, e, f, g, h, i, j, ga, ea, on, ac}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
 	e.set(ne, el)
 	f.set(ne, el)
+	g.set(ne, el)
+	h.set(ne, el)
+	i.set(ne, el)
+	j.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -3857,6 +4507,8 @@ func Tr(content ...any) *TrElement {
 // ButtonElement represents the <button> element.
 type ButtonElement struct {
 	*element
+	*attrCommand[ButtonElement]
+	*attrCommandFor[ButtonElement]
 	*attrDisabled[ButtonElement]
 	*attrForm[ButtonElement]
 	*attrFormAction[ButtonElement]
@@ -3875,6 +4527,18 @@ type ButtonElement struct {
 	*addContentFunc[ButtonElement]
 }
 
+// ButtonType is the behavior of a button, the value of its type attribute.
+type ButtonType string
+
+const (
+	// ButtonTypeSubmit submits the form (default).
+	ButtonTypeSubmit ButtonType = "submit"
+	// ButtonTypeReset resets the controls of the form.
+	ButtonTypeReset ButtonType = "reset"
+	// ButtonTypeButton does nothing by default.
+	ButtonTypeButton ButtonType = "button"
+)
+
 // Type specifies the type of an element.
 // The default behavior of the button.
 //
@@ -3882,8 +4546,8 @@ type ButtonElement struct {
 //   - submit: the button submits the form data to the server. This is the default if the attribute is not specified for buttons associated with a <form>, or if the attribute is an empty or invalid value.
 //   - reset: the button resets all the controls to their initial values, like <input type="reset">. (This behavior tends to annoy users.)
 //   - button: the button has no default behavior, and does nothing when pressed by default. It can have client-side scripts listen to the element's events, which are triggered when the events occur.
-func (p *ButtonElement) Type(value string) *ButtonElement {
-	p.addAttribute("type", value)
+func (p *ButtonElement) Type(value ButtonType) *ButtonElement {
+	addKeyword(p.element, "type", value, ButtonTypeSubmit, ButtonTypeReset, ButtonTypeButton)
 	return p
 }
 
@@ -3897,22 +4561,24 @@ func (p *ButtonElement) Type(value string) *ButtonElement {
 func Button(content ...any) *ButtonElement {
 	ne := newElement("button", true, content...)
 
-	var a = new(attrDisabled[ButtonElement])
-	var b = new(attrForm[ButtonElement])
-	var c = new(attrFormAction[ButtonElement])
-	var d = new(attrFormEncType[ButtonElement])
-	var e = new(attrFormMethod[ButtonElement])
-	var f = new(attrFormNoValidate[ButtonElement])
-	var g = new(attrFormTarget[ButtonElement])
-	var h = new(attrName[ButtonElement])
-	var i = new(attrPopoverTarget[ButtonElement])
-	var j = new(attrPopoverTargetAction[ButtonElement])
-	var k = new(attrValue[ButtonElement])
+	var a = new(attrCommand[ButtonElement])
+	var b = new(attrCommandFor[ButtonElement])
+	var c = new(attrDisabled[ButtonElement])
+	var d = new(attrForm[ButtonElement])
+	var e = new(attrFormAction[ButtonElement])
+	var f = new(attrFormEncType[ButtonElement])
+	var g = new(attrFormMethod[ButtonElement])
+	var h = new(attrFormNoValidate[ButtonElement])
+	var i = new(attrFormTarget[ButtonElement])
+	var j = new(attrName[ButtonElement])
+	var k = new(attrPopoverTarget[ButtonElement])
+	var l = new(attrPopoverTargetAction[ButtonElement])
+	var m = new(attrValue[ButtonElement])
 	var ga = new(attrGlobal[ButtonElement])
 	var ea = new(attrExternalAttributes[ButtonElement])
 	var on = new(attrOn[ButtonElement])
 	var ac = new(addContentFunc[ButtonElement])
-	var el = &ButtonElement{ne, a, b, c, d, e, f, g, h, i, j, k, ga, ea, on, ac}
+	var el = &ButtonElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, m, ga, ea, on, ac}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
@@ -3924,6 +4590,8 @@ func Button(content ...any) *ButtonElement {
 	i.set(ne, el)
 	j.set(ne, el)
 	k.set(ne, el)
+	l.set(ne, el)
+	m.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -4075,10 +4743,12 @@ func Form(content ...any) *FormElement {
 type InputElement struct {
 	*element
 	*attrAccept[InputElement]
+	*attrAlpha[InputElement]
 	*attrAlt[InputElement]
 	*attrAutoComplete[InputElement]
 	*attrCapture[InputElement]
 	*attrChecked[InputElement]
+	*attrColorSpace[InputElement]
 	*attrDirName[InputElement]
 	*attrDisabled[InputElement]
 	*attrForm[InputElement]
@@ -4087,11 +4757,13 @@ type InputElement struct {
 	*attrFormMethod[InputElement]
 	*attrFormNoValidate[InputElement]
 	*attrFormTarget[InputElement]
+	*attrHeight[InputElement]
 	*attrList[InputElement]
 	*attrMax[InputElement]
 	*attrMaxLength[InputElement]
 	*attrMin[InputElement]
 	*attrMinLength[InputElement]
+	*attrMultiple[InputElement]
 	*attrName[InputElement]
 	*attrPattern[InputElement]
 	*attrPlaceholder[InputElement]
@@ -4103,12 +4775,42 @@ type InputElement struct {
 	*attrSrc[InputElement]
 	*attrStep[InputElement]
 	*attrValue[InputElement]
+	*attrWidth[InputElement]
 
 	*attrGlobal[InputElement]
 	*attrExternalAttributes[InputElement]
 	*attrOn[InputElement]
 }
 
+// InputType is a type of form control, the value of the type attribute of an
+// <input>.
+type InputType string
+
+const (
+	InputTypeButton        InputType = "button"
+	InputTypeCheckbox      InputType = "checkbox"
+	InputTypeColor         InputType = "color"
+	InputTypeDate          InputType = "date"
+	InputTypeDatetimeLocal InputType = "datetime-local"
+	InputTypeEmail         InputType = "email"
+	InputTypeFile          InputType = "file"
+	InputTypeHidden        InputType = "hidden"
+	InputTypeImage         InputType = "image"
+	InputTypeMonth         InputType = "month"
+	InputTypeNumber        InputType = "number"
+	InputTypePassword      InputType = "password"
+	InputTypeRadio         InputType = "radio"
+	InputTypeRange         InputType = "range"
+	InputTypeReset         InputType = "reset"
+	InputTypeSearch        InputType = "search"
+	InputTypeSubmit        InputType = "submit"
+	InputTypeTel           InputType = "tel"
+	InputTypeText          InputType = "text"
+	InputTypeTime          InputType = "time"
+	InputTypeURL           InputType = "url"
+	InputTypeWeek          InputType = "week"
+)
+
 // Type specifies the type of an element.
 //
 // The available types are as follows:
@@ -4134,8 +4836,8 @@ type InputElement struct {
 //   - time: a control for entering a time value with no time zone.
 //   - url: a field for entering a URL. Looks like a text input, but has validation parameters and relevant keyboard in supporting browsers and devices with dynamic keyboards.
 //   - week: a control for entering a date consisting of a week-year number and a week number with no time zone.
-func (p *InputElement) Type(value string) *InputElement {
-	p.addAttribute("type", value)
+func (p *InputElement) Type(value InputType) *InputElement {
+	addKeyword(p.element, "type", value, InputTypeButton, InputTypeCheckbox, InputTypeColor, InputTypeDate, InputTypeDatetimeLocal, InputTypeEmail, InputTypeFile, InputTypeHidden, InputTypeImage, InputTypeMonth, InputTypeNumber, InputTypePassword, InputTypeRadio, InputTypeRange, InputTypeReset, InputTypeSearch, InputTypeSubmit, InputTypeTel, InputTypeText, InputTypeTime, InputTypeURL, InputTypeWeek)
 	return p
 }
 
@@ -4153,39 +4855,43 @@ func Input() *InputElement {
 	ne := newElement("input", false)
 
 	var a = new(attrAccept[InputElement])
-	var b = new(attrAlt[InputElement])
-	var c = new(attrAutoComplete[InputElement])
-	var d = new(attrCapture[InputElement])
-	var e = new(attrChecked[InputElement])
-	var f = new(attrDirName[InputElement])
-	var g = new(attrDisabled[InputElement])
-	var h = new(attrForm[InputElement])
-	var i = new(attrFormAction[InputElement])
-	var j = new(attrFormEncType[InputElement])
-	var k = new(attrFormMethod[InputElement])
-	var l = new(attrFormNoValidate[InputElement])
-	var m = new(attrFormTarget[InputElement])
-	var n = new(attrList[InputElement])
-	var o = new(attrMax[InputElement])
-	var p = new(attrMaxLength[InputElement])
-	var q = new(attrMin[InputElement])
-	var r = new(attrMinLength[InputElement])
-	var s = new(attrName[InputElement])
-	var t = new(attrPattern[InputElement])
-	var u = new(attrPlaceholder[InputElement])
-	var v = new(attrPopoverTarget[InputElement])
-	var w = new(attrPopoverTargetAction[InputElement])
-	var aa = new(attrReadOnly[InputElement])
-	var ab = new(attrRequired[InputElement])
-	var ac = new(attrSize[InputElement])
-	var ad = new(attrSrc[InputElement])
-	var ae = new(attrStep[InputElement])
-	var af = new(attrValue[InputElement])
-
+	var b = new(attrAlpha[InputElement])
+	var c = new(attrAlt[InputElement])
+	var d = new(attrAutoComplete[InputElement])
+	var e = new(attrCapture[InputElement])
+	var f = new(attrChecked[InputElement])
+	var g = new(attrColorSpace[InputElement])
+	var h = new(attrDirName[InputElement])
+	var i = new(attrDisabled[InputElement])
+	var j = new(attrForm[InputElement])
+	var k = new(attrFormAction[InputElement])
+	var l = new(attrFormEncType[InputElement])
+	var m = new(attrFormMethod[InputElement])
+	var n = new(attrFormNoValidate[InputElement])
+	var o = new(attrFormTarget[InputElement])
+	var p = new(attrHeight[InputElement])
+	var q = new(attrList[InputElement])
+	var r = new(attrMax[InputElement])
+	var s = new(attrMaxLength[InputElement])
+	var t = new(attrMin[InputElement])
+	var u = new(attrMinLength[InputElement])
+	var v = new(attrMultiple[InputElement])
+	var w = new(attrName[InputElement])
+	var aa = new(attrPattern[InputElement])
+	var ab = new(attrPlaceholder[InputElement])
+	var ac = new(attrPopoverTarget[InputElement])
+	var ad = new(attrPopoverTargetAction[InputElement])
+	var ae = new(attrReadOnly[InputElement])
+	var af = new(attrRequired[InputElement])
+	var ag = new(attrSize[InputElement])
+	var ah = new(attrSrc[InputElement])
+	var ai = new(attrStep[InputElement])
+	var aj = new(attrValue[InputElement])
+	var ak = new(attrWidth[InputElement])
 	var ga = new(attrGlobal[InputElement])
 	var ea = new(attrExternalAttributes[InputElement])
 	var on = new(attrOn[InputElement])
-	var el = &InputElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, aa, ab, ac, ad, ae, af, ga, ea, on}
+	var el = &InputElement{ne, a, b, c, d, e, f, g, h, i, j, k, l, m, n, o, p, q, r, s, t, u, v, w, aa, ab, ac, ad, ae, af, ag, ah, ai, aj, ak, ga, ea, on}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
@@ -4215,7 +4921,11 @@ func Input() *InputElement {
 	ad.set(ne, el)
 	ae.set(ne, el)
 	af.set(ne, el)
-
+	ag.set(ne, el)
+	ah.set(ne, el)
+	ai.set(ne, el)
+	aj.set(ne, el)
+	ak.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -4296,12 +5006,13 @@ func Legend(content ...any) *LegendElement {
 // MeterElement represents the <h1> element.
 type MeterElement struct {
 	*element
-	*attrMin[MeterElement]
-	*attrMax[MeterElement]
-	*attrLow[MeterElement]
+	*attrForm[MeterElement]
 	*attrHigh[MeterElement]
+	*attrLow[MeterElement]
+	*attrMax[MeterElement]
+	*attrMin[MeterElement]
 	*attrOptimum[MeterElement]
-	*attrForm[MeterElement]
+	*attrValue[MeterElement]
 
 	*attrGlobal[MeterElement]
 	*attrExternalAttributes[MeterElement]
@@ -4317,23 +5028,25 @@ type MeterElement struct {
 func Meter(content ...any) *MeterElement {
 	ne := newElement("meter", true, content...)
 
-	var a = new(attrMin[MeterElement])
-	var b = new(attrMax[MeterElement])
+	var a = new(attrForm[MeterElement])
+	var b = new(attrHigh[MeterElement])
 	var c = new(attrLow[MeterElement])
-	var d = new(attrHigh[MeterElement])
-	var e = new(attrOptimum[MeterElement])
-	var f = new(attrForm[MeterElement])
+	var d = new(attrMax[MeterElement])
+	var e = new(attrMin[MeterElement])
+	var f = new(attrOptimum[MeterElement])
+	var g = new(attrValue[MeterElement])
 	var ga = new(attrGlobal[MeterElement])
 	var ea = new(attrExternalAttributes[MeterElement])
 	var on = new(attrOn[MeterElement])
 	var ac = new(addContentFunc[MeterElement])
-	var el = &MeterElement{ne, a, b, c, d, e, f, ga, ea, on, ac}
+	var el = &MeterElement{ne, a, b, c, d, e, f, g, ga, ea, on, ac}
 	a.set(ne, el)
 	b.set(ne, el)
 	c.set(ne, el)
 	d.set(ne, el)
 	e.set(ne, el)
 	f.set(ne, el)
+	g.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -4598,6 +5311,45 @@ func Select(content ...any) *SelectElement {
 	return el
 }
 
+// #region <selectedcontent>
+
+// SelectedContentElement represents the <selectedcontent> element.
+type SelectedContentElement struct {
+	*element
+	*attrGlobal[SelectedContentElement]
+	*attrExternalAttributes[SelectedContentElement]
+	*attrOn[SelectedContentElement]
+}
+
+// SelectedContent shows a copy of the content of the selected <option> of a
+// customizable <select>. It must be in the <button> that is the first child
+// of the <select>; the browser fills it, so it has no content.
+//
+// Example:
+//
+//	Select(
+//		Button(SelectedContent()),
+//		Option(Img().Src("/flags/fr.svg").Alt(""), "France").Value("fr"),
+//		Option(Img().Src("/flags/jp.svg").Alt(""), "Japan").Value("jp"),
+//	).Name("country")
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/selectedcontent
+func SelectedContent() *SelectedContentElement {
+	ne := newElement("selectedcontent", true)
+
+	var ga = new(attrGlobal[SelectedContentElement])
+	var ea = new(attrExternalAttributes[SelectedContentElement])
+	var on = new(attrOn[SelectedContentElement])
+	var el = &SelectedContentElement{ne, ga, ea, on}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+
+	return el
+}
+
 // #region <textarea>
 
 // TextareaElement represents the <textarea> element.
@@ -4748,6 +5500,7 @@ func Details(content ...any) *DetailsElement {
 // DialogElement represents the <dialog> element.
 type DialogElement struct {
 	*element
+	*attrClosedBy[DialogElement]
 	*attrOpen[DialogElement]
 
 	*attrGlobal[DialogElement]
@@ -4774,13 +5527,15 @@ type DialogElement struct {
 func Dialog(content ...any) *DialogElement {
 	ne := newElement("dialog", true, content...)
 
-	var a = new(attrOpen[DialogElement])
+	var a = new(attrClosedBy[DialogElement])
+	var b = new(attrOpen[DialogElement])
 	var ga = new(attrGlobal[DialogElement])
 	var ea = new(attrExternalAttributes[DialogElement])
 	var on = new(attrOn[DialogElement])
 	var ac = new(addContentFunc[DialogElement])
-	var el = &DialogElement{ne, a, ga, ea, on, ac}
+	var el = &DialogElement{ne, a, b, ga, ea, on, ac}
 	a.set(ne, el)
+	b.set(ne, el)
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -4870,6 +5625,7 @@ func Slot(content ...any) *SlotElement {
 // TemplateElement represents the <template> element.
 type TemplateElement struct {
 	*element
+	*attrShadowRoot[TemplateElement]
 
 	*attrGlobal[TemplateElement]
 	*attrExternalAttributes[TemplateElement]
@@ -4882,17 +5638,93 @@ type TemplateElement struct {
 // page is loaded but may be instantiated subsequently during runtime using
 // JavaScript.
 //
+// With ShadowRootMode, the template is a declarative shadow root of its parent
+// element instead. See CustomElement.ShadowRoot.
+//
 // For more details, see the [documentation]
 //
 // [documentation]: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/template
 func Template(content ...any) *TemplateElement {
 	ne := newElement("template", true, content...)
 
+	var a = new(attrShadowRoot[TemplateElement])
 	var ga = new(attrGlobal[TemplateElement])
 	var ea = new(attrExternalAttributes[TemplateElement])
 	var on = new(attrOn[TemplateElement])
 	var ac = new(addContentFunc[TemplateElement])
-	var el = &TemplateElement{ne, ga, ea, on, ac}
+	var el = &TemplateElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region CUSTOM ELEMENTS
+
+// #region <custom>
+
+// CustomElement represents any element that is not defined by the package,
+// like web components (autonomous custom elements).
+//
+// Note: It is used for the unknown elements found by Parse.
+type CustomElement struct {
+	*element
+	*attrGlobal[CustomElement]
+	*attrExternalAttributes[CustomElement]
+	*attrOn[CustomElement]
+	*addContentFunc[CustomElement]
+}
+
+// Custom creates a custom element, like a web component (<sl-button>) or a
+// tag of a framework (<turbo-frame>). It has the global attributes,
+// AddAttributes, On and AddContent, like the standard elements.
+//
+// The name must be a valid custom element name: it starts with a lowercase
+// ASCII letter, contains a hyphen, has no uppercase ASCII letters and is not
+// one of the names reserved by SVG and MathML, like "font-face". In Strict
+// mode, an invalid name is recorded as ErrInvalidElement. A name that can't
+// be written as a tag, like one with spaces or ">", is never written: only
+// the content of the element is rendered.
+//
+// Example:
+//
+//	Custom("sl-button", "Save").AddAttributes(`variant="primary"`)
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://html.spec.whatwg.org/multipage/custom-elements.html#valid-custom-element-name
+func Custom(name string, content ...any) *CustomElement {
+	el := newCustomElement(name, content...)
+	el.checkCustomName()
+	return el
+}
+
+// CustomVoid creates a custom element written without end tag, like
+// <x-icon name="check"/>. Its content is not rendered.
+//
+// Warning: browsers don't parse custom elements as void elements, so the
+// next content of the page ends up inside them. Use it for the tags of tools
+// that read the HTML, like templating frameworks.
+func CustomVoid(name string) *CustomElement {
+	el := newCustomElement(name)
+	el.hasClosingTag = false
+	el.checkCustomName()
+	return el
+}
+
+// newCustomElement creates an element with the given tag. The tag is not
+// validated.
+func newCustomElement(tag string, content ...any) *CustomElement {
+	ne := newElement(tag, true, content...)
+
+	var ga = new(attrGlobal[CustomElement])
+	var ea = new(attrExternalAttributes[CustomElement])
+	var on = new(attrOn[CustomElement])
+	var ac = new(addContentFunc[CustomElement])
+	var el = &CustomElement{ne, ga, ea, on, ac}
 	ga.set(ne, el)
 	ea.set(ne, el)
 	on.set(ne, el)
@@ -4900,3 +5732,124 @@ func Template(content ...any) *TemplateElement {
 
 	return el
 }
+
+// ShadowRoot attaches a declarative shadow root to the element: the template
+// becomes its first child, so the browser moves its content into the shadow
+// root when the page is parsed, without JavaScript. The template gets
+// shadowrootmode="open" when it has no mode, and replaces the shadow root
+// attached before.
+//
+// The shadow root shows the content of the element in its <slot> elements:
+// the content added with Slotted in the slot with that name, and the rest of
+// the content in the slot without name.
+//
+// Example:
+//
+//	Custom("user-card").ShadowRoot(
+//		Template(
+//			Style(":host { display: block }"),
+//			H2(Slot().Name("name")),
+//			Slot(),
+//		),
+//	).Slotted("name", "Ada Lovelace").AddContent(
+//		P("Mathematician"),
+//	)
+func (p *CustomElement) ShadowRoot(template *TemplateElement) *CustomElement {
+	if _, ok := template.attributeValue("shadowrootmode"); !ok {
+		template.ShadowRootMode(ShadowRootOpen)
+	}
+
+	if len(p.content) > 0 {
+		if t, ok := p.content[0].(*TemplateElement); ok {
+			if _, ok := t.attributeValue("shadowrootmode"); ok {
+				p.content[0] = template
+				return p
+			}
+		}
+	}
+	p.insertContent(0, template)
+	return p
+}
+
+// Slotted adds content shown in the <slot> called name of the shadow root
+// of the element. The elements get the slot attribute; text and the other
+// content are wrapped in a <span>, as only elements can be assigned to a
+// named slot. With an empty name, the content is added as is, for the slot
+// without name.
+func (p *CustomElement) Slotted(name string, content ...any) *CustomElement {
+	if name == "" {
+		p.addContent(content...)
+		return p
+	}
+
+	for _, c := range content {
+		if e, ok := c.(elementer); ok && e.elem().tag != "" {
+			e.elem().setAttribute("slot", name)
+			p.addContent(c)
+			continue
+		}
+		p.addContent(Span(c).Slot(name))
+	}
+	return p
+}
+
+// checkCustomName records an invalid custom element name and removes the
+// tag when it can't be written.
+func (p *CustomElement) checkCustomName() {
+	if ValidCustomElementName(p.tag) {
+		return
+	}
+
+	if Strict {
+		p.addError(fmt.Errorf("%w %q", ErrInvalidElement, p.tag))
+	}
+	if !validTagName(p.tag) {
+		p.tag = ""
+	}
+}
+
+// reservedCustomElementNames are the names with a hyphen used by SVG and
+// MathML elements.
+var reservedCustomElementNames = []string{
+	"annotation-xml", "color-profile", "font-face", "font-face-src",
+	"font-face-uri", "font-face-format", "font-face-name", "missing-glyph",
+}
+
+// ValidCustomElementName reports whether name is a valid custom element name:
+// it starts with a lowercase ASCII letter, has a hyphen and no uppercase
+// letters, and is not one of the names reserved by SVG and MathML.
+//
+// https://html.spec.whatwg.org/multipage/custom-elements.html#valid-custom-element-name
+func ValidCustomElementName(name string) bool {
+	if name == "" || name[0] < 'a' || name[0] > 'z' || !strings.Contains(name, "-") {
+		return false
+	}
+	if slices.Contains(reservedCustomElementNames, name) {
+		return false
+	}
+
+	for _, r := range name {
+		switch {
+		case r == '-', r == '.', r == '_', r >= '0' && r <= '9', r >= 'a' && r <= 'z':
+		case r == 0xb7, r >= 0xc0 && r <= 0xd6, r >= 0xd8 && r <= 0xf6,
+			r >= 0xf8 && r <= 0x37d, r >= 0x37f && r <= 0x1fff,
+			r >= 0x200c && r <= 0x200d, r >= 0x203f && r <= 0x2040,
+			r >= 0x2070 && r <= 0x218f, r >= 0x2c00 && r <= 0x2fef,
+			r >= 0x3001 && r <= 0xd7ff, r >= 0xf900 && r <= 0xfdcf,
+			r >= 0xfdf0 && r <= 0xfffd, r >= 0x10000 && r <= 0xeffff:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// validTagName reports whether name can be written as a tag: it starts with
+// an ASCII letter and has no characters that would end the tag.
+func validTagName(name string) bool {
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z' || name[0] >= 'A' && name[0] <= 'Z') {
+		return false
+	}
+	return validAttributeName(name)
+}
@@ -0,0 +1,74 @@
+package renderHTML
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Strict makes the elements record the mistakes found while they are built,
+// like an attribute set twice, an invalid attribute name or value, or a
+// malformed CSS declaration. The errors are returned by the Errors method of
+// the element or of any of its ancestors, and RenderStrict refuses to render
+// a tree that has them.
+//
+// When it is false (the default) the mistakes are silently resolved: for
+// example, the last value of a duplicate attribute is kept, an attribute with
+// an invalid name is discarded, and an invalid value of an enumerated
+// attribute is written as given. The elements are built the same way in both
+// modes; Strict only records what was resolved. Like AutoEscape,
+// it must be set before any element is built, typically in tests:
+//
+//	func TestMain(m *testing.M) {
+//		renderHTML.Strict = true
+//		os.Exit(m.Run())
+//	}
+var Strict = false
+
+// ErrDuplicateAttribute is recorded in Strict mode when an attribute is added
+// to an element that already has it. SetAttribute doesn't record it.
+var ErrDuplicateAttribute = errors.New("duplicate attribute")
+
+// ErrInvalidValue is recorded in Strict mode when an enumerated attribute,
+// like target or loading, is set to a value that is not one of its keywords.
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidAttribute is recorded in Strict mode when an attribute is
+// discarded because its name is invalid, like an empty name or one with
+// spaces or quotes, or because it is malformed in the text received by
+// AddAttributes.
+var ErrInvalidAttribute = errors.New("invalid attribute")
+
+// ErrInvalidElement is recorded in Strict mode when Custom or CustomVoid
+// receive a name that is not a valid custom element name.
+var ErrInvalidElement = errors.New("invalid element name")
+
+// ErrMissingID is recorded in Strict mode when an element without id is
+// swapped out of band by OOBResponse.Swap, as htmx finds the element to
+// replace by its id.
+var ErrMissingID = errors.New("out of band swap without id")
+
+// addError records an error of the element.
+func (p *element) addError(err error) {
+	p.errs = append(p.errs, fmt.Errorf("<%s>: %w", p.tag, err))
+}
+
+// Errors returns the errors recorded in Strict mode while building the
+// element and its descendants, in document order. It returns nil when there
+// are none.
+//
+// Example:
+//
+//	renderHTML.Strict = true
+//	page := viewCustomerPage(customer)
+//	for _, err := range page.Errors() {
+//		t.Error(err) // <a>: duplicate attribute "href"
+//	}
+func (p *element) Errors() []error {
+	errs := slices.Clone(p.errs)
+	p.walk(func(n node) bool {
+		errs = append(errs, n.el.errs...)
+		return true
+	})
+	return errs
+}
@@ -0,0 +1,952 @@
+package renderHTML
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// #region HTMX ATTRIBUTES
+// htmx gives access to AJAX, CSS Transitions, WebSockets and Server Sent
+// Events directly in HTML, using attributes.
+//
+// https://htmx.org/reference/
+
+// HxAttributes is a set of htmx attributes. It implements the method
+// GetAttributes, so it can be included in any element with AddAttributes:
+//
+//	Button("Save").AddAttributes(
+//		Hx().Post("/customer").Target("#div-one").Swap(SwapInnerHTML, SwapTransition(true)),
+//	)
+//
+// Setting the same attribute twice keeps the last value.
+type HxAttributes struct {
+	attrs []attr
+}
+
+// Hx creates an empty set of htmx attributes.
+func Hx() *HxAttributes {
+	return &HxAttributes{}
+}
+
+// GetAttributes returns the attributes as HTML text, one attribute per item.
+func (p *HxAttributes) GetAttributes() []string {
+	s := make([]string, 0, len(p.attrs))
+	for _, a := range p.attrs {
+		if !a.hasValue {
+			s = append(s, a.name)
+			continue
+		}
+		s = append(s, a.name+`="`+escape(attributeValueContext(a.name), a.value)+`"`)
+	}
+	return s
+}
+
+// attributes returns the attributes without converting them to text. It is
+// used by AddAttributes.
+func (p *HxAttributes) attributes() []attr {
+	return p.attrs
+}
+
+func (p *HxAttributes) set(name string, value ...string) *HxAttributes {
+	a := attr{name: name}
+	if value != nil {
+		a.value = value[0]
+		a.hasValue = true
+	}
+
+	for i := range p.attrs {
+		if p.attrs[i].name == name {
+			p.attrs[i] = a
+			return p
+		}
+	}
+
+	p.attrs = append(p.attrs, a)
+	return p
+}
+
+// #region hx-get, hx-post...
+
+// Get issues a GET request to the given URL.
+func (p *HxAttributes) Get(url string) *HxAttributes {
+	return p.set("hx-get", url)
+}
+
+// Post issues a POST request to the given URL.
+func (p *HxAttributes) Post(url string) *HxAttributes {
+	return p.set("hx-post", url)
+}
+
+// Put issues a PUT request to the given URL.
+func (p *HxAttributes) Put(url string) *HxAttributes {
+	return p.set("hx-put", url)
+}
+
+// Patch issues a PATCH request to the given URL.
+func (p *HxAttributes) Patch(url string) *HxAttributes {
+	return p.set("hx-patch", url)
+}
+
+// Delete issues a DELETE request to the given URL.
+func (p *HxAttributes) Delete(url string) *HxAttributes {
+	return p.set("hx-delete", url)
+}
+
+// #region hx-target
+
+// Target specifies the element to be swapped, instead of the one issuing the
+// request. It is a CSS selector or one of the extended selectors of htmx:
+// "this", "closest <selector>", "find <selector>", "next", "next <selector>",
+// "previous" and "previous <selector>".
+func (p *HxAttributes) Target(selector string) *HxAttributes {
+	return p.set("hx-target", selector)
+}
+
+// #region hx-swap
+
+// HxSwap is a swap strategy: how the response is swapped in relative to the
+// target.
+type HxSwap string
+
+// Swap strategies.
+const (
+	// SwapInnerHTML replaces the inner html of the target (default).
+	SwapInnerHTML HxSwap = "innerHTML"
+	// SwapOuterHTML replaces the entire target with the response.
+	SwapOuterHTML HxSwap = "outerHTML"
+	// SwapTextContent replaces the text content of the target, without
+	// parsing the response as HTML.
+	SwapTextContent HxSwap = "textContent"
+	// SwapBeforeBegin inserts the response before the target.
+	SwapBeforeBegin HxSwap = "beforebegin"
+	// SwapAfterBegin inserts the response before the first child of the
+	// target.
+	SwapAfterBegin HxSwap = "afterbegin"
+	// SwapBeforeEnd inserts the response after the last child of the target.
+	SwapBeforeEnd HxSwap = "beforeend"
+	// SwapAfterEnd inserts the response after the target.
+	SwapAfterEnd HxSwap = "afterend"
+	// SwapDelete deletes the target regardless of the response.
+	SwapDelete HxSwap = "delete"
+	// SwapNone does not append content from the response (out of band items
+	// will still be processed).
+	SwapNone HxSwap = "none"
+)
+
+// HxSwapModifier modifies how a swap is done. Use the Swap* functions to
+// create them.
+type HxSwapModifier string
+
+// SwapTransition uses the View Transitions API for the swap.
+func SwapTransition(value bool) HxSwapModifier {
+	return HxSwapModifier("transition:" + strconv.FormatBool(value))
+}
+
+// SwapDelay sets the time between the response being received and the swap.
+func SwapDelay(d time.Duration) HxSwapModifier {
+	return HxSwapModifier("swap:" + hxDuration(d))
+}
+
+// SwapSettle sets the time between the swap and the settle logic.
+func SwapSettle(d time.Duration) HxSwapModifier {
+	return HxSwapModifier("settle:" + hxDuration(d))
+}
+
+// SwapIgnoreTitle keeps the document title, even if the response contains a
+// <title> element.
+func SwapIgnoreTitle(value bool) HxSwapModifier {
+	return HxSwapModifier("ignoreTitle:" + strconv.FormatBool(value))
+}
+
+// SwapScroll scrolls the target, or the element matched by the optional
+// selector, to the given position: "top" or "bottom".
+func SwapScroll(position string, selector ...string) HxSwapModifier {
+	if len(selector) > 0 {
+		return HxSwapModifier("scroll:" + selector[0] + ":" + position)
+	}
+	return HxSwapModifier("scroll:" + position)
+}
+
+// SwapShow scrolls the page so that the target, or the element matched by the
+// optional selector, is shown at the given position: "top" or "bottom".
+func SwapShow(position string, selector ...string) HxSwapModifier {
+	if len(selector) > 0 {
+		return HxSwapModifier("show:" + selector[0] + ":" + position)
+	}
+	return HxSwapModifier("show:" + position)
+}
+
+// SwapFocusScroll indicates whether the focused element is scrolled into view
+// after the swap.
+func SwapFocusScroll(value bool) HxSwapModifier {
+	return HxSwapModifier("focus-scroll:" + strconv.FormatBool(value))
+}
+
+// Swap controls how the content is swapped in relative to the target.
+//
+// Example:
+//
+//	Hx().Swap(SwapOuterHTML, SwapSettle(time.Second), SwapShow("top"))
+func (p *HxAttributes) Swap(style HxSwap, modifiers ...HxSwapModifier) *HxAttributes {
+	v := string(style)
+	for _, m := range modifiers {
+		v += " " + string(m)
+	}
+	return p.set("hx-swap", strings.TrimSpace(v))
+}
+
+// #region hx-swap-oob
+
+// SwapOOB marks the element to be swapped in "out of band": it replaces the
+// element with the same id in the page instead of being included in the
+// target. The optional selector swaps it relative to other elements.
+//
+// Example:
+//
+//	Div("3 new messages").Id("alerts").AddAttributes(Hx().SwapOOB(SwapOuterHTML))
+func (p *HxAttributes) SwapOOB(style HxSwap, selector ...string) *HxAttributes {
+	v := string(style)
+	if v == "" {
+		v = "true"
+	}
+	if len(selector) > 0 {
+		v += ":" + selector[0]
+	}
+	return p.set("hx-swap-oob", v)
+}
+
+// #region hx-trigger
+
+// HxTrigger is an event that triggers a request. Use TriggerOn, TriggerEvery,
+// TriggerLoad, TriggerRevealed or TriggerIntersect to create them.
+type HxTrigger string
+
+// HxTriggerModifier modifies how an event triggers a request. Use the
+// Trigger* functions to create them.
+type HxTriggerModifier string
+
+// TriggerOn triggers the request on the given event.
+//
+// Example:
+//
+//	TriggerOn("keyup", TriggerChanged(), TriggerDelay(500*time.Millisecond))
+func TriggerOn(event string, modifiers ...HxTriggerModifier) HxTrigger {
+	var filter, mods string
+	for _, m := range modifiers {
+		if strings.HasPrefix(string(m), "[") {
+			filter += string(m)
+		} else {
+			mods += " " + string(m)
+		}
+	}
+	return HxTrigger(event + filter + mods)
+}
+
+// TriggerEvery polls the server at the given interval. The optional filter is
+// a JavaScript expression that must be true to issue the request.
+func TriggerEvery(d time.Duration, filter ...string) HxTrigger {
+	v := "every " + hxDuration(d)
+	if len(filter) > 0 {
+		v += " [" + filter[0] + "]"
+	}
+	return HxTrigger(v)
+}
+
+// TriggerLoad triggers the request when the element is loaded.
+func TriggerLoad(modifiers ...HxTriggerModifier) HxTrigger {
+	return TriggerOn("load", modifiers...)
+}
+
+// TriggerRevealed triggers the request when the element is scrolled into the
+// viewport.
+func TriggerRevealed(modifiers ...HxTriggerModifier) HxTrigger {
+	return TriggerOn("revealed", modifiers...)
+}
+
+// TriggerIntersect triggers the request once when the element first
+// intersects the viewport.
+func TriggerIntersect(modifiers ...HxTriggerModifier) HxTrigger {
+	return TriggerOn("intersect", modifiers...)
+}
+
+// TriggerOnce triggers the request only once.
+func TriggerOnce() HxTriggerModifier {
+	return "once"
+}
+
+// TriggerChanged triggers the request only if the value of the element has
+// changed.
+func TriggerChanged() HxTriggerModifier {
+	return "changed"
+}
+
+// TriggerDelay waits the given time before issuing the request. If the event
+// triggers again, the countdown is reset.
+func TriggerDelay(d time.Duration) HxTriggerModifier {
+	return HxTriggerModifier("delay:" + hxDuration(d))
+}
+
+// TriggerThrottle issues the request at most once in the given time.
+func TriggerThrottle(d time.Duration) HxTriggerModifier {
+	return HxTriggerModifier("throttle:" + hxDuration(d))
+}
+
+// TriggerFrom listens for the event on the elements matched by the given
+// extended CSS selector, instead of on the element itself.
+func TriggerFrom(selector string) HxTriggerModifier {
+	return HxTriggerModifier("from:" + selector)
+}
+
+// TriggerTarget filters the event by the CSS selector of its target.
+func TriggerTarget(selector string) HxTriggerModifier {
+	return HxTriggerModifier("target:" + selector)
+}
+
+// TriggerConsume prevents the event from triggering requests on parent
+// elements.
+func TriggerConsume() HxTriggerModifier {
+	return "consume"
+}
+
+// TriggerQueue determines how events are queued while a request is in flight:
+// "first", "last", "all" or "none".
+func TriggerQueue(option string) HxTriggerModifier {
+	return HxTriggerModifier("queue:" + option)
+}
+
+// TriggerFilter issues the request only when the JavaScript expression is
+// true, for example "ctrlKey".
+func TriggerFilter(expression string) HxTriggerModifier {
+	return HxTriggerModifier("[" + expression + "]")
+}
+
+// Trigger specifies the events that trigger the request.
+//
+// Example:
+//
+//	Hx().Get("/search").Trigger(
+//		TriggerOn("input", TriggerChanged(), TriggerDelay(500*time.Millisecond)),
+//		TriggerOn("search"),
+//	)
+func (p *HxAttributes) Trigger(triggers ...HxTrigger) *HxAttributes {
+	s := make([]string, len(triggers))
+	for i, t := range triggers {
+		s[i] = string(t)
+	}
+	return p.set("hx-trigger", strings.Join(s, ", "))
+}
+
+// #region hx-vals, hx-headers
+
+// Vals adds values to the parameters submitted with the request. The value is
+// encoded as JSON, so it is usually a map or a struct. If it can't be encoded,
+// the attribute is not added.
+func (p *HxAttributes) Vals(values any) *HxAttributes {
+	b, err := json.Marshal(values)
+	if err != nil {
+		return p
+	}
+	return p.set("hx-vals", string(b))
+}
+
+// Headers adds headers to the request.
+func (p *HxAttributes) Headers(headers map[string]string) *HxAttributes {
+	b, _ := json.Marshal(headers)
+	return p.set("hx-headers", string(b))
+}
+
+// #region hx-boost, hx-push-url...
+
+// Boost converts the links and forms of the element and its descendants into
+// AJAX requests that replace the body of the page.
+func (p *HxAttributes) Boost(value bool) *HxAttributes {
+	return p.set("hx-boost", strconv.FormatBool(value))
+}
+
+// PushURL pushes a URL into the browser location history: "true" pushes the
+// URL of the request, "false" disables pushing, and any other value is the
+// URL to push.
+func (p *HxAttributes) PushURL(value string) *HxAttributes {
+	return p.set("hx-push-url", value)
+}
+
+// ReplaceURL replaces the current URL of the browser location history: "true"
+// uses the URL of the request, "false" disables it, and any other value is
+// the URL to use.
+func (p *HxAttributes) ReplaceURL(value string) *HxAttributes {
+	return p.set("hx-replace-url", value)
+}
+
+// Select selects the content to swap from the response, using a CSS selector.
+func (p *HxAttributes) Select(selector string) *HxAttributes {
+	return p.set("hx-select", selector)
+}
+
+// SelectOOB selects content from the response to be swapped in "out of band",
+// using a comma separated list of element ids, optionally followed by a colon
+// and a swap strategy.
+func (p *HxAttributes) SelectOOB(selectors ...string) *HxAttributes {
+	return p.set("hx-select-oob", strings.Join(selectors, ","))
+}
+
+// Include includes the values of additional elements in the request, using an
+// extended CSS selector.
+func (p *HxAttributes) Include(selector string) *HxAttributes {
+	return p.set("hx-include", selector)
+}
+
+// Indicator specifies the element that receives the htmx-request class during
+// the request.
+func (p *HxAttributes) Indicator(selector string) *HxAttributes {
+	return p.set("hx-indicator", selector)
+}
+
+// Params filters the parameters submitted with the request: "*", "none",
+// "not <param-list>" or "<param-list>".
+func (p *HxAttributes) Params(value string) *HxAttributes {
+	return p.set("hx-params", value)
+}
+
+// Confirm shows a confirm() dialog with the message before issuing the
+// request.
+func (p *HxAttributes) Confirm(message string) *HxAttributes {
+	return p.set("hx-confirm", message)
+}
+
+// Prompt shows a prompt() with the message before issuing the request. The
+// answer is sent in the HX-Prompt header.
+func (p *HxAttributes) Prompt(message string) *HxAttributes {
+	return p.set("hx-prompt", message)
+}
+
+// Sync synchronizes the requests of several elements, for example
+// "closest form:abort".
+func (p *HxAttributes) Sync(value string) *HxAttributes {
+	return p.set("hx-sync", value)
+}
+
+// Encoding changes the request encoding, usually to "multipart/form-data".
+func (p *HxAttributes) Encoding(value string) *HxAttributes {
+	return p.set("hx-encoding", value)
+}
+
+// Ext enables the htmx extensions with the given names.
+func (p *HxAttributes) Ext(names ...string) *HxAttributes {
+	return p.set("hx-ext", strings.Join(names, ","))
+}
+
+// Disable disables htmx processing for the element and its descendants.
+func (p *HxAttributes) Disable() *HxAttributes {
+	return p.set("hx-disable")
+}
+
+// DisabledElt adds the disabled attribute to the elements matched by the
+// extended CSS selector during the request.
+func (p *HxAttributes) DisabledElt(selector string) *HxAttributes {
+	return p.set("hx-disabled-elt", selector)
+}
+
+// Disinherit disables the inheritance of the given attributes, or "*" for all
+// of them.
+func (p *HxAttributes) Disinherit(attributes ...string) *HxAttributes {
+	return p.set("hx-disinherit", strings.Join(attributes, " "))
+}
+
+// Inherit enables the inheritance of the given attributes, or "*" for all of
+// them, when inheritance is disabled in the configuration.
+func (p *HxAttributes) Inherit(attributes ...string) *HxAttributes {
+	return p.set("hx-inherit", strings.Join(attributes, " "))
+}
+
+// Preserve keeps the element unchanged between requests. The element needs an
+// id.
+func (p *HxAttributes) Preserve() *HxAttributes {
+	return p.set("hx-preserve")
+}
+
+// Validate forces the element to validate itself before a request.
+func (p *HxAttributes) Validate(value bool) *HxAttributes {
+	return p.set("hx-validate", strconv.FormatBool(value))
+}
+
+// History prevents sensitive data from being saved to the history cache when
+// set to false.
+func (p *HxAttributes) History(value bool) *HxAttributes {
+	return p.set("hx-history", strconv.FormatBool(value))
+}
+
+// HistoryElt specifies the element to snapshot and restore during history
+// navigation.
+func (p *HxAttributes) HistoryElt() *HxAttributes {
+	return p.set("hx-history-elt")
+}
+
+// On handles an event with inline JavaScript. Events of htmx can be written
+// as "htmx:after-request" or "::after-request".
+func (p *HxAttributes) On(event, script string) *HxAttributes {
+	return p.set("hx-on:"+event, script)
+}
+
+// #region HTMX REQUESTS
+// htmx sends information about the request in HTTP headers, and the server
+// can change how the response is handled with other HTTP headers.
+//
+// https://htmx.org/reference/#headers
+
+// HxRequestHeaders are the htmx headers of a request.
+type HxRequestHeaders struct {
+	// Request is true when the request was issued by htmx (HX-Request).
+	Request bool
+
+	// Boosted is true when the request comes from an element using hx-boost
+	// (HX-Boosted).
+	Boosted bool
+
+	// HistoryRestoreRequest is true when the request restores the history
+	// after a miss in the local history cache (HX-History-Restore-Request).
+	HistoryRestoreRequest bool
+
+	// CurrentURL is the current URL of the browser (HX-Current-URL).
+	CurrentURL string
+
+	// Prompt is the user response to an hx-prompt (HX-Prompt).
+	Prompt string
+
+	// Target is the id of the target element, if it exists (HX-Target).
+	Target string
+
+	// Trigger is the id of the triggered element, if it exists
+	// (HX-Trigger).
+	Trigger string
+
+	// TriggerName is the name of the triggered element, if it exists
+	// (HX-Trigger-Name).
+	TriggerName string
+}
+
+// HxRequest returns the htmx headers of the request.
+func HxRequest(r *http.Request) HxRequestHeaders {
+	h := r.Header
+	return HxRequestHeaders{
+		Request:               h.Get("HX-Request") == "true",
+		Boosted:               h.Get("HX-Boosted") == "true",
+		HistoryRestoreRequest: h.Get("HX-History-Restore-Request") == "true",
+		CurrentURL:            h.Get("HX-Current-URL"),
+		Prompt:                h.Get("HX-Prompt"),
+		Target:                h.Get("HX-Target"),
+		Trigger:               h.Get("HX-Trigger"),
+		TriggerName:           h.Get("HX-Trigger-Name"),
+	}
+}
+
+// IsHx reports whether the request was issued by htmx.
+func IsHx(r *http.Request) bool {
+	return r.Header.Get("HX-Request") == "true"
+}
+
+// WantsFragment reports whether the request expects a fragment of a page
+// instead of a full document: it was issued by htmx, it is not boosted and it
+// is not restoring the history.
+func WantsFragment(r *http.Request) bool {
+	hx := HxRequest(r)
+	return hx.Request && !hx.Boosted && !hx.HistoryRestoreRequest
+}
+
+// HxHandler returns a handler that responds with fragment to the requests that
+// expect a fragment (see WantsFragment) and with page to any other request.
+//
+// The response includes the header "Vary: HX-Request", so caches store both
+// versions separately.
+//
+// Example:
+//
+//	mux.Handle("GET /customers", HxHandler(viewCustomerList, viewCustomersPage))
+func HxHandler(fragment, page ViewFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "HX-Request")
+
+		if WantsFragment(r) {
+			fragment.ServeHTTP(w, r)
+			return
+		}
+		page.ServeHTTP(w, r)
+	})
+}
+
+// #region HTMX RESPONSES
+
+// HxResponseHeaders sets the htmx headers of a response. The headers must be
+// set before the response is written, that is, before calling Respond.
+//
+// Example:
+//
+//	HxResponse(w).Retarget("#errors").Reswap(SwapInnerHTML).Trigger("saved", map[string]int{"id": 7})
+//	Respond(w, r, http.StatusUnprocessableEntity, viewErrors(errs))
+type HxResponseHeaders struct {
+	h        http.Header
+	triggers map[string]*hxEvents
+}
+
+// hxEvents are the events of one of the HX-Trigger headers.
+type hxEvents struct {
+	names   []string
+	details map[string]any
+}
+
+// HxResponse returns the htmx headers of the response.
+func HxResponse(w http.ResponseWriter) *HxResponseHeaders {
+	return &HxResponseHeaders{h: w.Header()}
+}
+
+// Location does a client-side redirect that doesn't do a full page reload
+// (HX-Location).
+func (p *HxResponseHeaders) Location(url string) *HxResponseHeaders {
+	p.h.Set("HX-Location", url)
+	return p
+}
+
+// Redirect does a client-side redirect to a new location, with a full page
+// reload (HX-Redirect).
+func (p *HxResponseHeaders) Redirect(url string) *HxResponseHeaders {
+	p.h.Set("HX-Redirect", url)
+	return p
+}
+
+// Refresh does a full refresh of the page (HX-Refresh).
+func (p *HxResponseHeaders) Refresh() *HxResponseHeaders {
+	p.h.Set("HX-Refresh", "true")
+	return p
+}
+
+// PushURL pushes a new URL into the history stack (HX-Push-Url). "false"
+// prevents the history from being updated.
+func (p *HxResponseHeaders) PushURL(url string) *HxResponseHeaders {
+	p.h.Set("HX-Push-Url", url)
+	return p
+}
+
+// ReplaceURL replaces the current URL in the location bar (HX-Replace-Url).
+// "false" prevents the location from being updated.
+func (p *HxResponseHeaders) ReplaceURL(url string) *HxResponseHeaders {
+	p.h.Set("HX-Replace-Url", url)
+	return p
+}
+
+// Retarget updates the target of the content update to the element matched by
+// the CSS selector (HX-Retarget).
+func (p *HxResponseHeaders) Retarget(selector string) *HxResponseHeaders {
+	p.h.Set("HX-Retarget", selector)
+	return p
+}
+
+// Reswap changes how the response is swapped (HX-Reswap).
+func (p *HxResponseHeaders) Reswap(style HxSwap, modifiers ...HxSwapModifier) *HxResponseHeaders {
+	v := string(style)
+	for _, m := range modifiers {
+		v += " " + string(m)
+	}
+	p.h.Set("HX-Reswap", strings.TrimSpace(v))
+	return p
+}
+
+// Reselect chooses the part of the response that is swapped in, using a CSS
+// selector (HX-Reselect).
+func (p *HxResponseHeaders) Reselect(selector string) *HxResponseHeaders {
+	p.h.Set("HX-Reselect", selector)
+	return p
+}
+
+// Trigger triggers a client-side event as soon as the response is received
+// (HX-Trigger). The optional detail is encoded as JSON and sent as the detail
+// of the event. It can be called several times to trigger several events;
+// the events already in the header, like those set by a middleware with
+// another HxResponse, are kept.
+func (p *HxResponseHeaders) Trigger(event string, detail ...any) *HxResponseHeaders {
+	return p.trigger("HX-Trigger", event, detail)
+}
+
+// TriggerAfterSettle triggers a client-side event after the settling step
+// (HX-Trigger-After-Settle). See Trigger.
+func (p *HxResponseHeaders) TriggerAfterSettle(event string, detail ...any) *HxResponseHeaders {
+	return p.trigger("HX-Trigger-After-Settle", event, detail)
+}
+
+// TriggerAfterSwap triggers a client-side event after the swap step
+// (HX-Trigger-After-Swap). See Trigger.
+func (p *HxResponseHeaders) TriggerAfterSwap(event string, detail ...any) *HxResponseHeaders {
+	return p.trigger("HX-Trigger-After-Swap", event, detail)
+}
+
+func (p *HxResponseHeaders) trigger(header, event string, detail []any) *HxResponseHeaders {
+	if p.triggers == nil {
+		p.triggers = make(map[string]*hxEvents)
+	}
+
+	e, ok := p.triggers[header]
+	if !ok {
+		// keep the events set by a previous HxResponse, like a middleware
+		e = parseHxEvents(p.h.Get(header))
+		p.triggers[header] = e
+	}
+
+	if _, ok := e.details[event]; !ok {
+		e.names = append(e.names, event)
+	}
+	e.details[event] = nil
+	if len(detail) > 0 {
+		e.details[event] = detail[0]
+	}
+
+	p.h.Set(header, e.String())
+	return p
+}
+
+// parseHxEvents parses the value of an HX-Trigger header, written as a comma
+// separated list of names or as a JSON object. The details are kept as raw
+// JSON.
+func parseHxEvents(value string) *hxEvents {
+	e := &hxEvents{details: make(map[string]any)}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return e
+	}
+
+	if strings.HasPrefix(value, "{") {
+		var names []string
+		details := make(map[string]any)
+		d := json.NewDecoder(strings.NewReader(value))
+		if _, err := d.Token(); err == nil {
+			for d.More() {
+				t, err := d.Token()
+				name, ok := t.(string)
+				if err != nil || !ok {
+					break
+				}
+				var detail json.RawMessage
+				if err := d.Decode(&detail); err != nil {
+					break
+				}
+				if _, ok := details[name]; !ok {
+					names = append(names, name)
+				}
+				details[name] = detail
+			}
+			if _, err := d.Token(); err == nil {
+				e.names, e.details = names, details
+				return e
+			}
+		}
+	}
+
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			if _, ok := e.details[name]; !ok {
+				e.names = append(e.names, name)
+			}
+			e.details[name] = nil
+		}
+	}
+	return e
+}
+
+// String returns the value of the header: a comma separated list of names
+// when no event has details, or a JSON object otherwise.
+func (p *hxEvents) String() string {
+	withDetails := false
+	for _, d := range p.details {
+		if d != nil {
+			withDetails = true
+			break
+		}
+	}
+
+	if !withDetails {
+		return strings.Join(p.names, ", ")
+	}
+
+	// the events are written in the order they were triggered.
+	var s strings.Builder
+	s.WriteString("{")
+	for i, name := range p.names {
+		if i > 0 {
+			s.WriteString(",")
+		}
+
+		k, _ := json.Marshal(name)
+		v, err := json.Marshal(p.details[name])
+		if err != nil {
+			v = []byte(fmt.Sprintf("%q", fmt.Sprint(p.details[name])))
+		}
+		s.Write(k)
+		s.WriteString(":")
+		s.Write(v)
+	}
+	s.WriteString("}")
+
+	return s.String()
+}
+
+// #region OUT OF BAND SWAPS
+
+// OOBResponse is the body of a response that updates several parts of the
+// page at once: a primary fragment, swapped into the target of the request,
+// and any number of elements swapped "out of band" into the elements with the
+// same id.
+//
+// Example:
+//
+//	Respond(w, r, http.StatusOK, OOB(viewCustomerRow(c)).
+//		Swap(SwapOuterHTML, Span(count).Id("customer-count")).
+//		SwapTarget(SwapAfterBegin, "#notifications", Div("Customer saved")),
+//	)
+type OOBResponse struct {
+	primary *UntaggedElement
+	oob     []fmt.Stringer
+}
+
+// OOB creates a response whose primary fragment is the given content.
+func OOB(primary ...any) *OOBResponse {
+	return &OOBResponse{primary: Container(primary...)}
+}
+
+// Swap adds elements swapped out of band with the given strategy. Each element
+// replaces, or is swapped into, the element of the page with its same id, so
+// the elements must have an id; in Strict mode, an element without id is
+// recorded as ErrMissingID. Its hx-swap-oob attribute is set by Swap. The
+// elements of a Container are swapped one by one, and those rendered by a
+// component given with Use get hx-swap-oob when the response is rendered.
+// Nil elements, like a (*DivElement)(nil), are ignored.
+//
+// Elements like <tr> or <li> can't be parsed by the browser outside their
+// parents; wrap them in a Template to swap them out of band.
+func (p *OOBResponse) Swap(style HxSwap, elements ...fmt.Stringer) *OOBResponse {
+	return p.swap(string(style), true, elements)
+}
+
+// SwapTarget adds elements swapped out of band with the given strategy into
+// the elements matched by the CSS selector, instead of the elements with
+// their same id.
+func (p *OOBResponse) SwapTarget(style HxSwap, selector string, elements ...fmt.Stringer) *OOBResponse {
+	return p.swap(string(style)+":"+selector, false, elements)
+}
+
+func (p *OOBResponse) swap(value string, byID bool, elements []fmt.Stringer) *OOBResponse {
+	for _, el := range elements {
+		if isNil(el) {
+			continue
+		}
+		switch e := el.(type) {
+		case elementer:
+			setSwapOOB(e.elem(), value, byID)
+		case *ComponentElement:
+			el = &oobComponent{e, value, byID}
+		}
+		p.oob = append(p.oob, el)
+	}
+	return p
+}
+
+// setSwapOOB sets the hx-swap-oob attribute of el or, when it has no tag, of
+// the elements and components it contains, as the attribute of an untagged
+// element is never rendered. byID reports whether the elements are swapped
+// by their id.
+func setSwapOOB(el *element, value string, byID bool) {
+	if el.tag == "" {
+		for i, c := range el.content {
+			switch e := c.(type) {
+			case elementer:
+				setSwapOOB(e.elem(), value, byID)
+			case *ComponentElement:
+				el.content[i] = &oobComponent{e, value, byID}
+			}
+		}
+		return
+	}
+
+	el.setAttribute("hx-swap-oob", value)
+	if id, _ := el.attributeValue("id"); byID && Strict && strings.TrimSpace(id) == "" {
+		el.addError(ErrMissingID)
+	}
+}
+
+// oobComponent is a component swapped out of band. Its elements only exist
+// while it is rendered, so hx-swap-oob is set on them then.
+type oobComponent struct {
+	component *ComponentElement
+	value     string
+	byID      bool
+}
+
+func (p *oobComponent) String() string {
+	return renderString(p)
+}
+
+func (p *oobComponent) render(r *renderer) {
+	view := p.component.view(r.ctx)
+	if view == nil {
+		return
+	}
+	if e, ok := view.(elementer); ok {
+		setSwapOOB(e.elem(), p.value, p.byID)
+	}
+	r.renderNode(view)
+}
+
+// Errors returns the errors recorded in Strict mode in the elements of the
+// response, like element.Errors does. The errors of the elements rendered by
+// components are not included, as they only exist while they are rendered.
+func (p *OOBResponse) Errors() []error {
+	errs := p.primary.Errors()
+	for _, el := range p.oob {
+		if e, ok := el.(elementer); ok {
+			errs = append(errs, e.elem().Errors()...)
+		}
+	}
+	return errs
+}
+
+// String returns HTML text of the response.
+func (p *OOBResponse) String() string {
+	return renderString(p)
+}
+
+func (p *OOBResponse) render(r *renderer) {
+	p.primary.render(r)
+	for _, el := range p.oob {
+		r.renderNode(el)
+	}
+}
+
+// Render writes the HTML text of the response to w.
+func (p *OOBResponse) Render(w io.Writer) error {
+	r := newRenderer(w)
+	p.render(r)
+	return r.err
+}
+
+// WriteTo writes the HTML text of the response to w. It implements
+// io.WriterTo.
+func (p *OOBResponse) WriteTo(w io.Writer) (int64, error) {
+	r := newRenderer(w)
+	p.render(r)
+	return r.n, r.err
+}
+
+// hxDuration formats d as an htmx time interval. The intervals are written in
+// milliseconds, so a fraction of a millisecond is rounded up and a short
+// delay is never written as 0ms.
+func hxDuration(d time.Duration) string {
+	if d%time.Second == 0 {
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+	ms := d.Milliseconds()
+	if d > 0 && d%time.Millisecond != 0 {
+		ms++
+	}
+	return strconv.FormatInt(ms, 10) + "ms"
+}
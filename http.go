@@ -0,0 +1,223 @@
+package renderHTML
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// #region HANDLERS
+
+// ViewFunc builds the view of a request. It implements http.Handler, so it
+// can be registered directly in an http.ServeMux:
+//
+//	mux.Handle("GET /customers", ViewFunc(func(r *http.Request) fmt.Stringer {
+//		return Html(Body(H1("Customers")))
+//	}))
+//
+// The view is sent with the status 200 (OK) unless it is wrapped with
+// WithStatus.
+type ViewFunc func(r *http.Request) fmt.Stringer
+
+// ServeHTTP implements http.Handler.
+func (f ViewFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	Respond(w, r, http.StatusOK, f(r))
+}
+
+// Handler returns an http.Handler that responds to every request with the
+// same view.
+func Handler(view fmt.Stringer) http.Handler {
+	return ViewFunc(func(*http.Request) fmt.Stringer { return view })
+}
+
+// #region WithStatus
+
+type statusView struct {
+	status int
+	view   fmt.Stringer
+}
+
+// String returns HTML text of the view.
+func (p *statusView) String() string {
+	if isNil(p.view) {
+		return ""
+	}
+	return p.view.String()
+}
+
+func (p *statusView) render(r *renderer) {
+	if !isNil(p.view) {
+		r.renderNode(p.view)
+	}
+}
+
+// WithStatus wraps view so that it is sent with the given HTTP status code by
+// Respond, ViewFunc and Handler.
+//
+// Example:
+//
+//	if customer == nil {
+//		return WithStatus(http.StatusNotFound, ErrorPage(http.StatusNotFound))
+//	}
+func WithStatus(status int, view fmt.Stringer) fmt.Stringer {
+	return &statusView{status: status, view: view}
+}
+
+// #region Respond
+
+var bufferPool = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+
+// Respond renders view and writes it as the response of the request, with the
+// given status code, unless view was wrapped with WithStatus.
+//
+// The response includes the headers Content-Type (when it isn't already set),
+// Content-Length and, for successful responses, an ETag computed from the
+// rendered HTML. If a GET or HEAD request has an If-None-Match header
+// matching the ETag, the status 304 (Not Modified) is sent without a body.
+//
+// The components in view are rendered with the context of the request. A nil
+// view, even a nil *DivElement or other element pointer, is sent as an empty
+// body. The request can be nil, in which case conditional requests are not
+// checked.
+func Respond(w http.ResponseWriter, r *http.Request, status int, view fmt.Stringer) error {
+	if v, ok := view.(*statusView); ok {
+		status = v.status
+	}
+
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufferPool.Put(buf)
+
+	rn := newRenderer(buf)
+	if r != nil {
+		rn.ctx = r.Context()
+	}
+	if !isNil(view) {
+		rn.renderNode(view)
+	}
+	if rn.err != nil {
+		Error(w, r, http.StatusInternalServerError)
+		return rn.err
+	}
+
+	h := w.Header()
+	if h.Get("Content-Type") == "" {
+		h.Set("Content-Type", "text/html; charset=utf-8")
+	}
+
+	if status == http.StatusOK && h.Get("ETag") == "" {
+		etag := computeETag(buf.Bytes())
+		h.Set("ETag", etag)
+
+		if r != nil && (r.Method == http.MethodGet || r.Method == http.MethodHead) && etagMatch(r.Header.Get("If-None-Match"), etag) {
+			h.Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
+	h.Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(status)
+
+	if r != nil && r.Method == http.MethodHead {
+		return nil
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Stream renders view directly into the response, with the given status code
+// unless view was wrapped with WithStatus.
+//
+// Unlike Respond, the HTML is not buffered, so the response has no
+// Content-Length nor ETag headers. It is useful for large documents.
+func Stream(w http.ResponseWriter, r *http.Request, status int, view fmt.Stringer) error {
+	if v, ok := view.(*statusView); ok {
+		status = v.status
+	}
+
+	h := w.Header()
+	if h.Get("Content-Type") == "" {
+		h.Set("Content-Type", "text/html; charset=utf-8")
+	}
+	w.WriteHeader(status)
+
+	if isNil(view) || (r != nil && r.Method == http.MethodHead) {
+		return nil
+	}
+
+	rn := newRenderer(w)
+	if r != nil {
+		rn.ctx = r.Context()
+	}
+	rn.renderNode(view)
+	return rn.err
+}
+
+// computeETag returns a strong entity tag of body.
+func computeETag(body []byte) string {
+	h := fnv.New64a()
+	h.Write(body)
+	return `"` + strconv.FormatUint(h.Sum64(), 36) + `"`
+}
+
+// etagMatch reports whether the If-None-Match header value matches etag,
+// using the weak comparison required for that header.
+func etagMatch(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// #region ERRORS
+
+// ErrorPage returns a minimal HTML document for the given HTTP status code.
+// The optional message is included in a paragraph below the title; when it
+// is omitted, nothing is added.
+//
+// Example:
+//
+//	ErrorPage(http.StatusNotFound, "The customer ", id, " does not exist.")
+func ErrorPage(status int, message ...any) *HtmlElement {
+	title := strconv.Itoa(status) + " " + http.StatusText(status)
+
+	body := Body(H1(title))
+	if len(message) > 0 {
+		body.AddContent(P(message...))
+	}
+
+	return Html().Lang("en").AddContent(
+		Head(
+			Meta().CharSet("utf-8"),
+			Meta().Name("viewport").Content("width=device-width, initial-scale=1.0"),
+			Title(title),
+		),
+		body,
+	)
+}
+
+// Error replies to the request with the error page of the given HTTP status
+// code. Like http.Error, it sets the X-Content-Type-Options header to
+// "nosniff" and removes any ETag set by the handler.
+func Error(w http.ResponseWriter, r *http.Request, status int, message ...any) {
+	h := w.Header()
+	h.Del("ETag")
+	h.Set("Content-Type", "text/html; charset=utf-8")
+	h.Set("X-Content-Type-Options", "nosniff")
+
+	Respond(w, r, status, ErrorPage(status, message...))
+}
@@ -0,0 +1,226 @@
+package renderHTML
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestViewFunc(t *testing.T) {
+	h := ViewFunc(func(r *http.Request) fmt.Stringer {
+		return P("Hello ", r.URL.Query().Get("name"))
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/?name=<Ann>", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("got status %v, want %v", rec.Code, http.StatusOK)
+	}
+	if got, want := rec.Body.String(), "<p>Hello &lt;Ann&gt;</p>"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, want := rec.Header().Get("Content-Type"), "text/html; charset=utf-8"; got != want {
+		t.Errorf("got Content-Type %v, want %v", got, want)
+	}
+	if got, want := rec.Header().Get("Content-Length"), fmt.Sprint(rec.Body.Len()); got != want {
+		t.Errorf("got Content-Length %v, want %v", got, want)
+	}
+
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("missing ETag")
+	}
+
+	req := httptest.NewRequest("GET", "/?name=<Ann>", nil)
+	req.Header.Set("If-None-Match", `"other", W/`+etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("got status %v, want %v", rec.Code, http.StatusNotModified)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("got body %v, want none", rec.Body.String())
+	}
+
+	req = httptest.NewRequest("POST", "/?name=<Ann>", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || rec.Body.Len() == 0 {
+		t.Errorf("POST: got status %v and body %v, want %v", rec.Code, rec.Body.String(), http.StatusOK)
+	}
+}
+
+func TestWithStatus(t *testing.T) {
+	h := Handler(WithStatus(http.StatusNotFound, ErrorPage(http.StatusNotFound, "No <customer>")))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("got status %v, want %v", rec.Code, http.StatusNotFound)
+	}
+	if rec.Header().Get("ETag") != "" {
+		t.Errorf("unexpected ETag in an error response")
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "<h1>404 Not Found</h1><p>No &lt;customer&gt;</p>") {
+		t.Errorf("unexpected body %v", body)
+	}
+}
+
+func TestNilView(t *testing.T) {
+	var div *DivElement
+	views := []fmt.Stringer{nil, div, WithStatus(http.StatusNotFound, div)}
+
+	for _, view := range views {
+		rec := httptest.NewRecorder()
+		if err := Respond(rec, nil, http.StatusOK, view); err != nil || rec.Body.Len() > 0 {
+			t.Errorf("%#v: got %v, %q", view, err, rec.Body.String())
+		}
+
+		rec = httptest.NewRecorder()
+		if err := Stream(rec, nil, http.StatusOK, view); err != nil || rec.Body.Len() > 0 {
+			t.Errorf("%#v: stream: got %v, %q", view, err, rec.Body.String())
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := Stream(rec, nil, http.StatusCreated, Div(P("a"))); err != nil {
+		t.Fatal(err)
+	}
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("got status %v, want %v", rec.Code, http.StatusCreated)
+	}
+	if got, want := rec.Body.String(), "<div><p>a</p></div>"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestHxHandler(t *testing.T) {
+	h := HxHandler(
+		func(r *http.Request) fmt.Stringer { return Div("fragment for ", HxRequest(r).Target) },
+		func(r *http.Request) fmt.Stringer { return Html(Body("page")) },
+	)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{"page", nil, "<!DOCTYPE html><html><body>page</body></html>"},
+		{"fragment", map[string]string{"HX-Request": "true", "HX-Target": "list"}, "<div>fragment for list</div>"},
+		{"boosted", map[string]string{"HX-Request": "true", "HX-Boosted": "true"}, "<!DOCTYPE html><html><body>page</body></html>"},
+		{"history", map[string]string{"HX-Request": "true", "HX-History-Restore-Request": "true"}, "<!DOCTYPE html><html><body>page</body></html>"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", "/", nil)
+		for k, v := range tt.headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if got := rec.Body.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+		if got := rec.Header().Get("Vary"); got != "HX-Request" {
+			t.Errorf("%v: got Vary %v, want HX-Request", tt.name, got)
+		}
+	}
+}
+
+func TestHxResponse(t *testing.T) {
+	rec := httptest.NewRecorder()
+	HxResponse(rec).
+		Retarget("#errors").
+		Reswap(SwapOuterHTML, SwapShow("top")).
+		Trigger("saved").
+		Trigger("notify", map[string]string{"level": "info"}).
+		TriggerAfterSwap("done").
+		PushURL("/customers/7")
+
+	h := rec.Header()
+	tests := map[string]string{
+		"HX-Retarget":           "#errors",
+		"HX-Reswap":             "outerHTML show:top",
+		"HX-Trigger":            `{"saved":null,"notify":{"level":"info"}}`,
+		"HX-Trigger-After-Swap": "done",
+		"HX-Push-Url":           "/customers/7",
+	}
+	for k, want := range tests {
+		if got := h.Get(k); got != want {
+			t.Errorf("%v: got %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestHxResponseMerge(t *testing.T) {
+	rec := httptest.NewRecorder()
+	HxResponse(rec).Trigger("a").Trigger("b")
+	HxResponse(rec).Trigger("c")
+	if got, want := rec.Header().Get("HX-Trigger"), "a, b, c"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	HxResponse(rec).Trigger("d", 1)
+	HxResponse(rec).Trigger("b", "x").TriggerAfterSettle("e")
+	if got, want := rec.Header().Get("HX-Trigger"), `{"a":null,"b":"x","c":null,"d":1}`; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, want := rec.Header().Get("HX-Trigger-After-Settle"), "e"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestOOB(t *testing.T) {
+	resp := OOB(Tr(Td("Ann"))).
+		Swap(SwapOuterHTML, Span(3).Id("count"), Div("x").Id("menu").AddAttributes(Hx().SwapOOB(SwapDelete))).
+		SwapTarget(SwapAfterBegin, "#notifications", Div("Saved"))
+
+	want := `<tr><td>Ann</td></tr>` +
+		`<span id="count" hx-swap-oob="outerHTML">3</span>` +
+		`<div id="menu" hx-swap-oob="outerHTML">x</div>` +
+		`<div hx-swap-oob="afterbegin:#notifications">Saved</div>`
+	if got := resp.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	rec := httptest.NewRecorder()
+	Respond(rec, nil, http.StatusOK, resp)
+	if got := rec.Body.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestOOBContainer(t *testing.T) {
+	resp := OOB(P("main")).Swap(SwapOuterHTML, Container(Div("1").Id("a"), Div("2").Id("b")))
+
+	want := `<p>main</p><div id="a" hx-swap-oob="outerHTML">1</div><div id="b" hx-swap-oob="outerHTML">2</div>`
+	if got := resp.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	resp = OOB(P("main")).Swap(SwapOuterHTML, (*DivElement)(nil), nil).SwapTarget(SwapBeforeEnd, "#list", (*LiElement)(nil))
+	if got, want := resp.String(), "<p>main</p>"; got != want {
+		t.Errorf("nil: got %v, want %v", got, want)
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+
+	resp = OOB(P("main")).Swap(SwapOuterHTML, Div("no id")).SwapTarget(SwapBeforeEnd, "#list", Li("x"))
+	errs := resp.Errors()
+	if len(errs) != 1 || !errors.Is(errs[0], ErrMissingID) {
+		t.Errorf("got %v, want %v", errs, ErrMissingID)
+	}
+}
@@ -0,0 +1,843 @@
+package renderHTML
+
+import "strconv"
+
+// #region MathML
+// MathML (Mathematical Markup Language) elements write mathematical formulas,
+// rendered natively by browsers. A formula is a Math element, whose content
+// is made of tokens, like identifiers (Mi), numbers (Mn) and operators (Mo),
+// grouped by layout elements, like Mrow, Mfrac or Msup.
+//
+// Like SVG elements, MathML elements are written with a self-closing tag when
+// they have no content.
+//
+// Example:
+//
+//	// x² + 1
+//	Math(Mrow(Msup(Mi("x"), Mn("2")), Mo("+"), Mn("1"))).Display(MathDisplayBlock)
+
+// mathNamespace is the XML namespace of the MathML elements.
+const mathNamespace = "http://www.w3.org/1998/Math/MathML"
+
+// #region MathML global attributes
+
+type attrMathGlobal[T any] struct {
+	attribute[T]
+}
+
+// MathVariant is a style of the characters of a token, the value of the
+// mathvariant attribute. Only MathVariantNormal is part of MathML Core, the
+// others come from MathML 3 and are not supported by all browsers.
+type MathVariant string
+
+const (
+	MathVariantNormal              MathVariant = "normal"
+	MathVariantBold                MathVariant = "bold"
+	MathVariantItalic              MathVariant = "italic"
+	MathVariantBoldItalic          MathVariant = "bold-italic"
+	MathVariantDoubleStruck        MathVariant = "double-struck"
+	MathVariantBoldFraktur         MathVariant = "bold-fraktur"
+	MathVariantScript              MathVariant = "script"
+	MathVariantBoldScript          MathVariant = "bold-script"
+	MathVariantFraktur             MathVariant = "fraktur"
+	MathVariantSansSerif           MathVariant = "sans-serif"
+	MathVariantBoldSansSerif       MathVariant = "bold-sans-serif"
+	MathVariantSansSerifItalic     MathVariant = "sans-serif-italic"
+	MathVariantSansSerifBoldItalic MathVariant = "sans-serif-bold-italic"
+	MathVariantMonospace           MathVariant = "monospace"
+	MathVariantInitial             MathVariant = "initial"
+	MathVariantTailed              MathVariant = "tailed"
+	MathVariantLooped              MathVariant = "looped"
+	MathVariantStretched           MathVariant = "stretched"
+)
+
+// MathVariant sets the style of the characters, like MathVariantNormal to
+// write a single letter <mi> upright instead of in italics.
+func (p *attrMathGlobal[T]) MathVariant(value MathVariant) *T {
+	addKeyword(p.el, "mathvariant", value, MathVariantNormal, MathVariantBold,
+		MathVariantItalic, MathVariantBoldItalic, MathVariantDoubleStruck,
+		MathVariantBoldFraktur, MathVariantScript, MathVariantBoldScript,
+		MathVariantFraktur, MathVariantSansSerif, MathVariantBoldSansSerif,
+		MathVariantSansSerifItalic, MathVariantSansSerifBoldItalic,
+		MathVariantMonospace, MathVariantInitial, MathVariantTailed,
+		MathVariantLooped, MathVariantStretched)
+	return p.t
+}
+
+// DisplayStyle sets whether the content is laid out as in a block formula
+// (true), with larger operators and limits above and below them, or as in an
+// inline formula (false).
+func (p *attrMathGlobal[T]) DisplayStyle(value bool) *T {
+	p.el.addAttribute("displaystyle", strconv.FormatBool(value))
+	return p.t
+}
+
+// ScriptLevel sets the level of the content, which decides the size of the
+// font. A number sets the level, and "+1" or "-1" changes it.
+func (p *attrMathGlobal[T]) ScriptLevel(value string) *T {
+	p.el.addAttribute("scriptlevel", value)
+	return p.t
+}
+
+// MathColor sets the color of the text, like the CSS color property.
+func (p *attrMathGlobal[T]) MathColor(value string) *T {
+	p.el.addAttribute("mathcolor", value)
+	return p.t
+}
+
+// MathBackground sets the background color, like the CSS background-color
+// property.
+func (p *attrMathGlobal[T]) MathBackground(value string) *T {
+	p.el.addAttribute("mathbackground", value)
+	return p.t
+}
+
+// MathSize sets the size of the font, like the CSS font-size property.
+func (p *attrMathGlobal[T]) MathSize(value string) *T {
+	p.el.addAttribute("mathsize", value)
+	return p.t
+}
+
+// #region <math>
+
+// MathElement represents the MathML <math> element.
+type MathElement struct {
+	*element
+	*attrMathGlobal[MathElement]
+
+	*attrGlobal[MathElement]
+	*attrExternalAttributes[MathElement]
+	*attrOn[MathElement]
+	*addContentFunc[MathElement]
+}
+
+// MathDisplay is a value of the display attribute of <math>.
+type MathDisplay string
+
+const (
+	// MathDisplayBlock displays the formula as a block, centered on its own
+	// line.
+	MathDisplayBlock MathDisplay = "block"
+	// MathDisplayInline displays the formula in the text (default).
+	MathDisplayInline MathDisplay = "inline"
+)
+
+// Display sets whether the formula is displayed as a block or inline.
+func (p *MathElement) Display(value MathDisplay) *MathElement {
+	addKeyword(p.element, "display", value, MathDisplayBlock, MathDisplayInline)
+	return p
+}
+
+// AltText sets a text alternative of the formula, for the browsers and
+// assistive technologies that don't support MathML.
+func (p *MathElement) AltText(value string) *MathElement {
+	p.addAttribute("alttext", value)
+	return p
+}
+
+// Math is the top-level element of a MathML formula. It is displayed inline by
+// default; Display(MathDisplayBlock) displays it as a block.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/math
+func Math(content ...any) *MathElement {
+	ne := newForeignElement("math", content...)
+	ne.addAttribute("xmlns", mathNamespace)
+
+	var a = new(attrMathGlobal[MathElement])
+	var ga = new(attrGlobal[MathElement])
+	var ea = new(attrExternalAttributes[MathElement])
+	var on = new(attrOn[MathElement])
+	var ac = new(addContentFunc[MathElement])
+	var el = &MathElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mi>
+
+// MiElement represents the MathML <mi> element.
+type MiElement struct {
+	*element
+	*attrMathGlobal[MiElement]
+
+	*attrGlobal[MiElement]
+	*attrExternalAttributes[MiElement]
+	*attrOn[MiElement]
+	*addContentFunc[MiElement]
+}
+
+// Mi is an identifier, like a variable or function name. A single letter is
+// written in italics.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mi
+func Mi(content ...any) *MiElement {
+	ne := newForeignElement("mi", content...)
+
+	var a = new(attrMathGlobal[MiElement])
+	var ga = new(attrGlobal[MiElement])
+	var ea = new(attrExternalAttributes[MiElement])
+	var on = new(attrOn[MiElement])
+	var ac = new(addContentFunc[MiElement])
+	var el = &MiElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mn>
+
+// MnElement represents the MathML <mn> element.
+type MnElement struct {
+	*element
+	*attrMathGlobal[MnElement]
+
+	*attrGlobal[MnElement]
+	*attrExternalAttributes[MnElement]
+	*attrOn[MnElement]
+	*addContentFunc[MnElement]
+}
+
+// Mn is a number.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mn
+func Mn(content ...any) *MnElement {
+	ne := newForeignElement("mn", content...)
+
+	var a = new(attrMathGlobal[MnElement])
+	var ga = new(attrGlobal[MnElement])
+	var ea = new(attrExternalAttributes[MnElement])
+	var on = new(attrOn[MnElement])
+	var ac = new(addContentFunc[MnElement])
+	var el = &MnElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mo>
+
+// MoElement represents the MathML <mo> element.
+type MoElement struct {
+	*element
+	*attrMathGlobal[MoElement]
+
+	*attrGlobal[MoElement]
+	*attrExternalAttributes[MoElement]
+	*attrOn[MoElement]
+	*addContentFunc[MoElement]
+}
+
+// MoForm is a value of the form attribute of <mo>.
+type MoForm string
+
+const (
+	MoFormPrefix  MoForm = "prefix"
+	MoFormInfix   MoForm = "infix"
+	MoFormPostfix MoForm = "postfix"
+)
+
+// Form sets the position of the operator in the expression, which decides
+// its spacing.
+func (p *MoElement) Form(value MoForm) *MoElement {
+	addKeyword(p.element, "form", value, MoFormPrefix, MoFormInfix, MoFormPostfix)
+	return p
+}
+
+// Fence sets whether the operator is a fence, like a parenthesis.
+func (p *MoElement) Fence(value bool) *MoElement {
+	p.addAttribute("fence", strconv.FormatBool(value))
+	return p
+}
+
+// Separator sets whether the operator is a separator, like a comma.
+func (p *MoElement) Separator(value bool) *MoElement {
+	p.addAttribute("separator", strconv.FormatBool(value))
+	return p
+}
+
+// Stretchy sets whether the operator stretches to the size of its content.
+func (p *MoElement) Stretchy(value bool) *MoElement {
+	p.addAttribute("stretchy", strconv.FormatBool(value))
+	return p
+}
+
+// Symmetric sets whether a stretchy operator stays symmetric around the
+// math axis.
+func (p *MoElement) Symmetric(value bool) *MoElement {
+	p.addAttribute("symmetric", strconv.FormatBool(value))
+	return p
+}
+
+// LargeOp sets whether the operator is drawn larger when the display style
+// is true, like "∑".
+func (p *MoElement) LargeOp(value bool) *MoElement {
+	p.addAttribute("largeop", strconv.FormatBool(value))
+	return p
+}
+
+// MovableLimits sets whether the limits of the operator are drawn as
+// scripts when the display style is false.
+func (p *MoElement) MovableLimits(value bool) *MoElement {
+	p.addAttribute("movablelimits", strconv.FormatBool(value))
+	return p
+}
+
+// LSpace sets the space before the operator, like "0.2em".
+func (p *MoElement) LSpace(value string) *MoElement {
+	p.addAttribute("lspace", value)
+	return p
+}
+
+// RSpace sets the space after the operator.
+func (p *MoElement) RSpace(value string) *MoElement {
+	p.addAttribute("rspace", value)
+	return p
+}
+
+// MinSize sets the minimum size of a stretchy operator.
+func (p *MoElement) MinSize(value string) *MoElement {
+	p.addAttribute("minsize", value)
+	return p
+}
+
+// MaxSize sets the maximum size of a stretchy operator.
+func (p *MoElement) MaxSize(value string) *MoElement {
+	p.addAttribute("maxsize", value)
+	return p
+}
+
+// Mo is an operator, like "+", "=", "(" or "∑".
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mo
+func Mo(content ...any) *MoElement {
+	ne := newForeignElement("mo", content...)
+
+	var a = new(attrMathGlobal[MoElement])
+	var ga = new(attrGlobal[MoElement])
+	var ea = new(attrExternalAttributes[MoElement])
+	var on = new(attrOn[MoElement])
+	var ac = new(addContentFunc[MoElement])
+	var el = &MoElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mrow>
+
+// MrowElement represents the MathML <mrow> element.
+type MrowElement struct {
+	*element
+	*attrMathGlobal[MrowElement]
+
+	*attrGlobal[MrowElement]
+	*attrExternalAttributes[MrowElement]
+	*attrOn[MrowElement]
+	*addContentFunc[MrowElement]
+}
+
+// Mrow groups subexpressions, like the content of parentheses.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mrow
+func Mrow(content ...any) *MrowElement {
+	ne := newForeignElement("mrow", content...)
+
+	var a = new(attrMathGlobal[MrowElement])
+	var ga = new(attrGlobal[MrowElement])
+	var ea = new(attrExternalAttributes[MrowElement])
+	var on = new(attrOn[MrowElement])
+	var ac = new(addContentFunc[MrowElement])
+	var el = &MrowElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mfrac>
+
+// MfracElement represents the MathML <mfrac> element.
+type MfracElement struct {
+	*element
+	*attrMathGlobal[MfracElement]
+
+	*attrGlobal[MfracElement]
+	*attrExternalAttributes[MfracElement]
+	*attrOn[MfracElement]
+	*addContentFunc[MfracElement]
+}
+
+// LineThickness sets the thickness of the fraction bar; "0" removes it, as
+// in binomial coefficients.
+func (p *MfracElement) LineThickness(value string) *MfracElement {
+	p.addAttribute("linethickness", value)
+	return p
+}
+
+// Mfrac is a fraction of its two children: the numerator and the denominator.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mfrac
+func Mfrac(content ...any) *MfracElement {
+	ne := newForeignElement("mfrac", content...)
+
+	var a = new(attrMathGlobal[MfracElement])
+	var ga = new(attrGlobal[MfracElement])
+	var ea = new(attrExternalAttributes[MfracElement])
+	var on = new(attrOn[MfracElement])
+	var ac = new(addContentFunc[MfracElement])
+	var el = &MfracElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <msqrt>
+
+// MsqrtElement represents the MathML <msqrt> element.
+type MsqrtElement struct {
+	*element
+	*attrMathGlobal[MsqrtElement]
+
+	*attrGlobal[MsqrtElement]
+	*attrExternalAttributes[MsqrtElement]
+	*attrOn[MsqrtElement]
+	*addContentFunc[MsqrtElement]
+}
+
+// Msqrt is the square root of its content.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/msqrt
+func Msqrt(content ...any) *MsqrtElement {
+	ne := newForeignElement("msqrt", content...)
+
+	var a = new(attrMathGlobal[MsqrtElement])
+	var ga = new(attrGlobal[MsqrtElement])
+	var ea = new(attrExternalAttributes[MsqrtElement])
+	var on = new(attrOn[MsqrtElement])
+	var ac = new(addContentFunc[MsqrtElement])
+	var el = &MsqrtElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mroot>
+
+// MrootElement represents the MathML <mroot> element.
+type MrootElement struct {
+	*element
+	*attrMathGlobal[MrootElement]
+
+	*attrGlobal[MrootElement]
+	*attrExternalAttributes[MrootElement]
+	*attrOn[MrootElement]
+	*addContentFunc[MrootElement]
+}
+
+// Mroot is a root of its two children: the base and the index.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mroot
+func Mroot(content ...any) *MrootElement {
+	ne := newForeignElement("mroot", content...)
+
+	var a = new(attrMathGlobal[MrootElement])
+	var ga = new(attrGlobal[MrootElement])
+	var ea = new(attrExternalAttributes[MrootElement])
+	var on = new(attrOn[MrootElement])
+	var ac = new(addContentFunc[MrootElement])
+	var el = &MrootElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <msup>
+
+// MsupElement represents the MathML <msup> element.
+type MsupElement struct {
+	*element
+	*attrMathGlobal[MsupElement]
+
+	*attrGlobal[MsupElement]
+	*attrExternalAttributes[MsupElement]
+	*attrOn[MsupElement]
+	*addContentFunc[MsupElement]
+}
+
+// Msup attaches a superscript to a base: its two children.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/msup
+func Msup(content ...any) *MsupElement {
+	ne := newForeignElement("msup", content...)
+
+	var a = new(attrMathGlobal[MsupElement])
+	var ga = new(attrGlobal[MsupElement])
+	var ea = new(attrExternalAttributes[MsupElement])
+	var on = new(attrOn[MsupElement])
+	var ac = new(addContentFunc[MsupElement])
+	var el = &MsupElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <msub>
+
+// MsubElement represents the MathML <msub> element.
+type MsubElement struct {
+	*element
+	*attrMathGlobal[MsubElement]
+
+	*attrGlobal[MsubElement]
+	*attrExternalAttributes[MsubElement]
+	*attrOn[MsubElement]
+	*addContentFunc[MsubElement]
+}
+
+// Msub attaches a subscript to a base: its two children.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/msub
+func Msub(content ...any) *MsubElement {
+	ne := newForeignElement("msub", content...)
+
+	var a = new(attrMathGlobal[MsubElement])
+	var ga = new(attrGlobal[MsubElement])
+	var ea = new(attrExternalAttributes[MsubElement])
+	var on = new(attrOn[MsubElement])
+	var ac = new(addContentFunc[MsubElement])
+	var el = &MsubElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <msubsup>
+
+// MsubsupElement represents the MathML <msubsup> element.
+type MsubsupElement struct {
+	*element
+	*attrMathGlobal[MsubsupElement]
+
+	*attrGlobal[MsubsupElement]
+	*attrExternalAttributes[MsubsupElement]
+	*attrOn[MsubsupElement]
+	*addContentFunc[MsubsupElement]
+}
+
+// Msubsup attaches a subscript and a superscript to a base: its three children.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/msubsup
+func Msubsup(content ...any) *MsubsupElement {
+	ne := newForeignElement("msubsup", content...)
+
+	var a = new(attrMathGlobal[MsubsupElement])
+	var ga = new(attrGlobal[MsubsupElement])
+	var ea = new(attrExternalAttributes[MsubsupElement])
+	var on = new(attrOn[MsubsupElement])
+	var ac = new(addContentFunc[MsubsupElement])
+	var el = &MsubsupElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mtable>
+
+// MtableElement represents the MathML <mtable> element.
+type MtableElement struct {
+	*element
+	*attrMathGlobal[MtableElement]
+
+	*attrGlobal[MtableElement]
+	*attrExternalAttributes[MtableElement]
+	*attrOn[MtableElement]
+	*addContentFunc[MtableElement]
+}
+
+// Mtable is a table or a matrix, with Mtr rows.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mtable
+func Mtable(content ...any) *MtableElement {
+	ne := newForeignElement("mtable", content...)
+
+	var a = new(attrMathGlobal[MtableElement])
+	var ga = new(attrGlobal[MtableElement])
+	var ea = new(attrExternalAttributes[MtableElement])
+	var on = new(attrOn[MtableElement])
+	var ac = new(addContentFunc[MtableElement])
+	var el = &MtableElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mtr>
+
+// MtrElement represents the MathML <mtr> element.
+type MtrElement struct {
+	*element
+	*attrMathGlobal[MtrElement]
+
+	*attrGlobal[MtrElement]
+	*attrExternalAttributes[MtrElement]
+	*attrOn[MtrElement]
+	*addContentFunc[MtrElement]
+}
+
+// Mtr is a row of an Mtable, with Mtd cells.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mtr
+func Mtr(content ...any) *MtrElement {
+	ne := newForeignElement("mtr", content...)
+
+	var a = new(attrMathGlobal[MtrElement])
+	var ga = new(attrGlobal[MtrElement])
+	var ea = new(attrExternalAttributes[MtrElement])
+	var on = new(attrOn[MtrElement])
+	var ac = new(addContentFunc[MtrElement])
+	var el = &MtrElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mtd>
+
+// MtdElement represents the MathML <mtd> element.
+type MtdElement struct {
+	*element
+	*attrMathGlobal[MtdElement]
+
+	*attrGlobal[MtdElement]
+	*attrExternalAttributes[MtdElement]
+	*attrOn[MtdElement]
+	*addContentFunc[MtdElement]
+}
+
+// ColumnSpan sets the number of columns the cell spans.
+func (p *MtdElement) ColumnSpan(value int) *MtdElement {
+	p.addAttribute("columnspan", value)
+	return p
+}
+
+// RowSpan sets the number of rows the cell spans.
+func (p *MtdElement) RowSpan(value int) *MtdElement {
+	p.addAttribute("rowspan", value)
+	return p
+}
+
+// Mtd is a cell of an Mtr.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mtd
+func Mtd(content ...any) *MtdElement {
+	ne := newForeignElement("mtd", content...)
+
+	var a = new(attrMathGlobal[MtdElement])
+	var ga = new(attrGlobal[MtdElement])
+	var ea = new(attrExternalAttributes[MtdElement])
+	var on = new(attrOn[MtdElement])
+	var ac = new(addContentFunc[MtdElement])
+	var el = &MtdElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mtext>
+
+// MtextElement represents the MathML <mtext> element.
+type MtextElement struct {
+	*element
+	*attrMathGlobal[MtextElement]
+
+	*attrGlobal[MtextElement]
+	*attrExternalAttributes[MtextElement]
+	*attrOn[MtextElement]
+	*addContentFunc[MtextElement]
+}
+
+// Mtext is text without mathematical meaning, like a comment in a formula.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/mtext
+func Mtext(content ...any) *MtextElement {
+	ne := newForeignElement("mtext", content...)
+
+	var a = new(attrMathGlobal[MtextElement])
+	var ga = new(attrGlobal[MtextElement])
+	var ea = new(attrExternalAttributes[MtextElement])
+	var on = new(attrOn[MtextElement])
+	var ac = new(addContentFunc[MtextElement])
+	var el = &MtextElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <semantics>
+
+// SemanticsElement represents the MathML <semantics> element.
+type SemanticsElement struct {
+	*element
+	*attrMathGlobal[SemanticsElement]
+
+	*attrGlobal[SemanticsElement]
+	*attrExternalAttributes[SemanticsElement]
+	*attrOn[SemanticsElement]
+	*addContentFunc[SemanticsElement]
+}
+
+// Semantics adds annotations, like the source in another notation, to an
+// expression: its first child.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/semantics
+func Semantics(content ...any) *SemanticsElement {
+	ne := newForeignElement("semantics", content...)
+
+	var a = new(attrMathGlobal[SemanticsElement])
+	var ga = new(attrGlobal[SemanticsElement])
+	var ea = new(attrExternalAttributes[SemanticsElement])
+	var on = new(attrOn[SemanticsElement])
+	var ac = new(addContentFunc[SemanticsElement])
+	var el = &SemanticsElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <annotation>
+
+// AnnotationElement represents the MathML <annotation> element.
+type AnnotationElement struct {
+	*element
+	*attrMathGlobal[AnnotationElement]
+
+	*attrGlobal[AnnotationElement]
+	*attrExternalAttributes[AnnotationElement]
+	*attrOn[AnnotationElement]
+	*addContentFunc[AnnotationElement]
+}
+
+// Encoding sets the format of the annotation, like "application/x-tex".
+func (p *AnnotationElement) Encoding(value string) *AnnotationElement {
+	p.addAttribute("encoding", value)
+	return p
+}
+
+// Annotation is an annotation of a Semantics element in a text format, like LaTeX.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/MathML/Element/annotation
+func Annotation(content ...any) *AnnotationElement {
+	ne := newForeignElement("annotation", content...)
+
+	var a = new(attrMathGlobal[AnnotationElement])
+	var ga = new(attrGlobal[AnnotationElement])
+	var ea = new(attrExternalAttributes[AnnotationElement])
+	var on = new(attrOn[AnnotationElement])
+	var ac = new(addContentFunc[AnnotationElement])
+	var el = &AnnotationElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
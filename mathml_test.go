@@ -0,0 +1,55 @@
+package renderHTML
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMathML(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"formula", Math(Mrow(Msup(Mi("x"), Mn("2")), Mo("+"), Mn("1"))).Display(MathDisplayBlock),
+			`<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mrow><msup><mi>x</mi><mn>2</mn></msup><mo>+</mo><mn>1</mn></mrow></math>`},
+		{"fraction", Mfrac(Mn("1"), Msqrt(Mi("π").MathVariant(MathVariantNormal))).LineThickness("0"),
+			`<mfrac linethickness="0"><mn>1</mn><msqrt><mi mathvariant="normal">π</mi></msqrt></mfrac>`},
+		{"operator", Mo("(").Fence(true).Stretchy(false).Form(MoFormPrefix), `<mo fence="true" stretchy="false" form="prefix">(</mo>`},
+		{"table", Mtable(Mtr(Mtd(Mn("1")).ColumnSpan(2), Mtd())).DisplayStyle(true),
+			`<mtable displaystyle="true"><mtr><mtd columnspan="2"><mn>1</mn></mtd><mtd/></mtr></mtable>`},
+		{"semantics", Semantics(Msub(Mi("a"), Mtext("<i>")), Annotation(`a_{\text{i}}`).Encoding("application/x-tex")),
+			`<semantics><msub><mi>a</mi><mtext>&lt;i&gt;</mtext></msub><annotation encoding="application/x-tex">a_{\text{i}}</annotation></semantics>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+
+	errs := Math(Mi("x").MathVariant("bolt")).Display("center").Errors()
+	want := `[<math>: invalid value "center" for attribute "display" <mi>: invalid value "bolt" for attribute "mathvariant"]`
+	if fmt.Sprint(errs) != want {
+		t.Errorf("got %v, want %v", errs, want)
+	}
+}
+
+func TestParseMathML(t *testing.T) {
+	src := `<p>a <math display=block><mfrac><mi>x</mi><mtext><b>y</b></mtext></mfrac><mspace width="1em"/></math> b</p>`
+	want := `<p>a <math display="block"><mfrac><mi>x</mi><mtext><b>y</b></mtext></mfrac><mspace width="1em"/></math> b</p>`
+
+	root := ParseString(src)
+	if got := root.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if _, ok := root.ByTag("mfrac")[0].(*MfracElement); !ok {
+		t.Errorf("got %T, want *MfracElement", root.ByTag("mfrac")[0])
+	}
+	if _, ok := root.ByTag("b")[0].(*BElement); !ok {
+		t.Errorf("got %T, want *BElement", root.ByTag("b")[0])
+	}
+}
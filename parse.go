@@ -0,0 +1,657 @@
+package renderHTML
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strings"
+)
+
+// #region PARSER
+
+// parseConstructors creates the element of every tag known by the package.
+// The tags that are not found are parsed as a CustomElement.
+var parseConstructors = map[string]func() fmt.Stringer{
+	"html":            func() fmt.Stringer { return Html() },
+	"base":            func() fmt.Stringer { return Base() },
+	"head":            func() fmt.Stringer { return Head() },
+	"link":            func() fmt.Stringer { return Link() },
+	"meta":            func() fmt.Stringer { return Meta() },
+	"style":           func() fmt.Stringer { return Style() },
+	"title":           func() fmt.Stringer { return Title() },
+	"body":            func() fmt.Stringer { return Body() },
+	"address":         func() fmt.Stringer { return Address() },
+	"article":         func() fmt.Stringer { return Article() },
+	"aside":           func() fmt.Stringer { return Aside() },
+	"footer":          func() fmt.Stringer { return Footer() },
+	"header":          func() fmt.Stringer { return Header() },
+	"h1":              func() fmt.Stringer { return H1() },
+	"h2":              func() fmt.Stringer { return H2() },
+	"h3":              func() fmt.Stringer { return H3() },
+	"h4":              func() fmt.Stringer { return H4() },
+	"h5":              func() fmt.Stringer { return H5() },
+	"h6":              func() fmt.Stringer { return H6() },
+	"hgroup":          func() fmt.Stringer { return Hgroup() },
+	"main":            func() fmt.Stringer { return Main() },
+	"nav":             func() fmt.Stringer { return Nav() },
+	"section":         func() fmt.Stringer { return Section() },
+	"search":          func() fmt.Stringer { return Search() },
+	"blockquote":      func() fmt.Stringer { return Blockquote() },
+	"dd":              func() fmt.Stringer { return Dd() },
+	"div":             func() fmt.Stringer { return Div() },
+	"dl":              func() fmt.Stringer { return Dl() },
+	"dt":              func() fmt.Stringer { return Dt() },
+	"figcaption":      func() fmt.Stringer { return FigCaption() },
+	"figure":          func() fmt.Stringer { return Figure() },
+	"hr":              func() fmt.Stringer { return Hr() },
+	"li":              func() fmt.Stringer { return Li() },
+	"menu":            func() fmt.Stringer { return Menu() },
+	"ol":              func() fmt.Stringer { return Ol() },
+	"p":               func() fmt.Stringer { return P() },
+	"pre":             func() fmt.Stringer { return Pre() },
+	"ul":              func() fmt.Stringer { return Ul() },
+	"a":               func() fmt.Stringer { return A() },
+	"abbr":            func() fmt.Stringer { return Abbr() },
+	"b":               func() fmt.Stringer { return B() },
+	"bdi":             func() fmt.Stringer { return Bdi() },
+	"bdo":             func() fmt.Stringer { return Bdo() },
+	"br":              func() fmt.Stringer { return Br() },
+	"cite":            func() fmt.Stringer { return Cite() },
+	"code":            func() fmt.Stringer { return Code() },
+	"data":            func() fmt.Stringer { return Data() },
+	"dfn":             func() fmt.Stringer { return Dfn() },
+	"em":              func() fmt.Stringer { return Em() },
+	"i":               func() fmt.Stringer { return I() },
+	"kbd":             func() fmt.Stringer { return Kbd() },
+	"mark":            func() fmt.Stringer { return Mark() },
+	"q":               func() fmt.Stringer { return Q() },
+	"rp":              func() fmt.Stringer { return Rp() },
+	"rt":              func() fmt.Stringer { return Rt() },
+	"ruby":            func() fmt.Stringer { return Ruby() },
+	"s":               func() fmt.Stringer { return S() },
+	"samp":            func() fmt.Stringer { return Samp() },
+	"small":           func() fmt.Stringer { return Small() },
+	"span":            func() fmt.Stringer { return Span() },
+	"strong":          func() fmt.Stringer { return Strong() },
+	"sub":             func() fmt.Stringer { return Sub() },
+	"sup":             func() fmt.Stringer { return Sup() },
+	"time":            func() fmt.Stringer { return Time() },
+	"u":               func() fmt.Stringer { return U() },
+	"var":             func() fmt.Stringer { return Var() },
+	"wbr":             func() fmt.Stringer { return Wbr() },
+	"area":            func() fmt.Stringer { return Area() },
+	"audio":           func() fmt.Stringer { return Audio() },
+	"img":             func() fmt.Stringer { return Img() },
+	"map":             func() fmt.Stringer { return Map() },
+	"track":           func() fmt.Stringer { return Track() },
+	"video":           func() fmt.Stringer { return Video() },
+	"embed":           func() fmt.Stringer { return Embed() },
+	"fencedframe":     func() fmt.Stringer { return FencedFrame() },
+	"iframe":          func() fmt.Stringer { return Iframe() },
+	"object":          func() fmt.Stringer { return Object() },
+	"picture":         func() fmt.Stringer { return Picture() },
+	"source":          func() fmt.Stringer { return Source() },
+	"svg":             func() fmt.Stringer { return Svg() },
+	"math":            func() fmt.Stringer { return Math() },
+	"canvas":          func() fmt.Stringer { return Canvas() },
+	"noscript":        func() fmt.Stringer { return NoScript() },
+	"script":          func() fmt.Stringer { return Script() },
+	"del":             func() fmt.Stringer { return Del() },
+	"ins":             func() fmt.Stringer { return Ins() },
+	"caption":         func() fmt.Stringer { return Caption() },
+	"col":             func() fmt.Stringer { return Col() },
+	"colgroup":        func() fmt.Stringer { return ColGroup() },
+	"table":           func() fmt.Stringer { return Table() },
+	"tbody":           func() fmt.Stringer { return Tbody() },
+	"td":              func() fmt.Stringer { return Td() },
+	"tfoot":           func() fmt.Stringer { return Tfoot() },
+	"th":              func() fmt.Stringer { return Th() },
+	"thead":           func() fmt.Stringer { return Thead() },
+	"tr":              func() fmt.Stringer { return Tr() },
+	"button":          func() fmt.Stringer { return Button() },
+	"datalist":        func() fmt.Stringer { return DataList() },
+	"fieldset":        func() fmt.Stringer { return FieldSet() },
+	"form":            func() fmt.Stringer { return Form() },
+	"input":           func() fmt.Stringer { return Input() },
+	"label":           func() fmt.Stringer { return Label() },
+	"legend":          func() fmt.Stringer { return Legend() },
+	"meter":           func() fmt.Stringer { return Meter() },
+	"optgroup":        func() fmt.Stringer { return OptGroup() },
+	"option":          func() fmt.Stringer { return Option() },
+	"output":          func() fmt.Stringer { return Output() },
+	"progress":        func() fmt.Stringer { return Progress() },
+	"select":          func() fmt.Stringer { return Select() },
+	"selectedcontent": func() fmt.Stringer { return SelectedContent() },
+	"textarea":        func() fmt.Stringer { return Textarea() },
+	"details":         func() fmt.Stringer { return Details() },
+	"dialog":          func() fmt.Stringer { return Dialog() },
+	"summary":         func() fmt.Stringer { return Summary() },
+	"slot":            func() fmt.Stringer { return Slot() },
+	"template":        func() fmt.Stringer { return Template() },
+}
+
+// svgConstructors creates the SVG elements known by the package, which are
+// parsed inside <svg>. The keys are lower case, as the tags are compared
+// ignoring case.
+var svgConstructors = map[string]func() fmt.Stringer{
+	"svg":            func() fmt.Stringer { return Svg() },
+	"g":              func() fmt.Stringer { return SvgG() },
+	"path":           func() fmt.Stringer { return SvgPath() },
+	"circle":         func() fmt.Stringer { return SvgCircle() },
+	"rect":           func() fmt.Stringer { return SvgRect() },
+	"line":           func() fmt.Stringer { return SvgLine() },
+	"polyline":       func() fmt.Stringer { return SvgPolyline() },
+	"polygon":        func() fmt.Stringer { return SvgPolygon() },
+	"text":           func() fmt.Stringer { return SvgText() },
+	"tspan":          func() fmt.Stringer { return SvgTSpan() },
+	"defs":           func() fmt.Stringer { return SvgDefs() },
+	"use":            func() fmt.Stringer { return SvgUse() },
+	"symbol":         func() fmt.Stringer { return SvgSymbol() },
+	"lineargradient": func() fmt.Stringer { return SvgLinearGradient() },
+	"stop":           func() fmt.Stringer { return SvgStop() },
+	"clippath":       func() fmt.Stringer { return SvgClipPath() },
+	"mask":           func() fmt.Stringer { return SvgMask() },
+	"title":          func() fmt.Stringer { return SvgTitle() },
+	"desc":           func() fmt.Stringer { return SvgDesc() },
+}
+
+// svgTagNames are the SVG tags with upper case letters, by their lower case
+// name. HTML is case-insensitive but SVG is not, so browsers restore their
+// case when parsing.
+//
+// https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-inforeign
+var svgTagNames = caseTable(
+	"altGlyph", "altGlyphDef", "altGlyphItem", "animateColor", "animateMotion",
+	"animateTransform", "clipPath", "feBlend", "feColorMatrix",
+	"feComponentTransfer", "feComposite", "feConvolveMatrix",
+	"feDiffuseLighting", "feDisplacementMap", "feDistantLight", "feDropShadow",
+	"feFlood", "feFuncA", "feFuncB", "feFuncG", "feFuncR", "feGaussianBlur",
+	"feImage", "feMerge", "feMergeNode", "feMorphology", "feOffset",
+	"fePointLight", "feSpecularLighting", "feSpotLight", "feTile",
+	"feTurbulence", "foreignObject", "glyphRef", "linearGradient",
+	"radialGradient", "textPath",
+)
+
+// svgAttributeNames are the SVG attributes with upper case letters, by their
+// lower case name.
+//
+// https://html.spec.whatwg.org/multipage/parsing.html#adjust-svg-attributes
+var svgAttributeNames = caseTable(
+	"attributeName", "attributeType", "baseFrequency", "baseProfile",
+	"calcMode", "clipPathUnits", "diffuseConstant", "edgeMode", "filterUnits",
+	"glyphRef", "gradientTransform", "gradientUnits", "kernelMatrix",
+	"kernelUnitLength", "keyPoints", "keySplines", "keyTimes", "lengthAdjust",
+	"limitingConeAngle", "markerHeight", "markerUnits", "markerWidth",
+	"maskContentUnits", "maskUnits", "numOctaves", "pathLength",
+	"patternContentUnits", "patternTransform", "patternUnits", "pointsAtX",
+	"pointsAtY", "pointsAtZ", "preserveAlpha", "preserveAspectRatio",
+	"primitiveUnits", "refX", "refY", "repeatCount", "repeatDur",
+	"requiredExtensions", "requiredFeatures", "specularConstant",
+	"specularExponent", "spreadMethod", "startOffset", "stdDeviation",
+	"stitchTiles", "surfaceScale", "systemLanguage", "tableValues", "targetX",
+	"targetY", "textLength", "viewBox", "viewTarget", "xChannelSelector",
+	"yChannelSelector", "zoomAndPan",
+)
+
+// mathConstructors creates the MathML elements known by the package, which
+// are parsed inside <math>.
+var mathConstructors = map[string]func() fmt.Stringer{
+	"math":       func() fmt.Stringer { return Math() },
+	"mi":         func() fmt.Stringer { return Mi() },
+	"mn":         func() fmt.Stringer { return Mn() },
+	"mo":         func() fmt.Stringer { return Mo() },
+	"mrow":       func() fmt.Stringer { return Mrow() },
+	"mfrac":      func() fmt.Stringer { return Mfrac() },
+	"msqrt":      func() fmt.Stringer { return Msqrt() },
+	"mroot":      func() fmt.Stringer { return Mroot() },
+	"msup":       func() fmt.Stringer { return Msup() },
+	"msub":       func() fmt.Stringer { return Msub() },
+	"msubsup":    func() fmt.Stringer { return Msubsup() },
+	"mtable":     func() fmt.Stringer { return Mtable() },
+	"mtr":        func() fmt.Stringer { return Mtr() },
+	"mtd":        func() fmt.Stringer { return Mtd() },
+	"mtext":      func() fmt.Stringer { return Mtext() },
+	"semantics":  func() fmt.Stringer { return Semantics() },
+	"annotation": func() fmt.Stringer { return Annotation() },
+}
+
+// foreignContent is the content of <svg> or <math>, whose tags are elements
+// of another vocabulary.
+type foreignContent struct {
+	constructors map[string]func() fmt.Stringer
+
+	// tagNames and attributeNames restore the case of the names, which HTML
+	// doesn't keep.
+	tagNames       map[string]string
+	attributeNames map[string]string
+}
+
+var (
+	svgContent  = &foreignContent{svgConstructors, svgTagNames, svgAttributeNames}
+	mathContent = &foreignContent{mathConstructors, nil, caseTable("definitionURL")}
+)
+
+// htmlIntegrationPoints are the foreign elements whose content is HTML: the
+// <foreignObject> of SVG and the token elements of MathML.
+var htmlIntegrationPoints = map[string]bool{
+	"foreignObject": true, "mi": true, "mo": true, "mn": true, "ms": true, "mtext": true,
+}
+
+// caseTable returns the names by their lower case version.
+func caseTable(names ...string) map[string]string {
+	m := make(map[string]string, len(names))
+	for _, name := range names {
+		m[strings.ToLower(name)] = name
+	}
+	return m
+}
+
+// rawTextElements are the elements whose content is not parsed as HTML. The
+// character references of <textarea> and <title> are decoded.
+var rawTextElements = map[string]bool{
+	"script": true, "style": true, "textarea": true, "title": true,
+}
+
+// closePElement are the elements that close an open <p> element.
+var closePElement = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"details": true, "dialog": true, "div": true, "dl": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true, "h1": true,
+	"h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "header": true,
+	"hgroup": true, "hr": true, "main": true, "menu": true, "nav": true,
+	"ol": true, "p": true, "pre": true, "search": true, "section": true,
+	"table": true, "ul": true,
+}
+
+// impliedEndTags lists, for the elements whose end tag can be omitted, the
+// open elements that are closed by them.
+var impliedEndTags = map[string][]string{
+	"li":       {"li"},
+	"dt":       {"dt", "dd"},
+	"dd":       {"dt", "dd"},
+	"option":   {"option"},
+	"optgroup": {"option", "optgroup"},
+	"tr":       {"td", "th", "tr"},
+	"td":       {"td", "th"},
+	"th":       {"td", "th"},
+	"thead":    {"td", "th", "tr", "thead", "tbody", "tfoot"},
+	"tbody":    {"td", "th", "tr", "thead", "tbody", "tfoot"},
+	"tfoot":    {"td", "th", "tr", "thead", "tbody", "tfoot"},
+	"rt":       {"rt", "rp"},
+	"rp":       {"rt", "rp"},
+}
+
+// parser builds the element tree. The open elements are kept in a stack,
+// whose first element is the returned container.
+type parser struct {
+	s     string
+	stack []*element
+}
+
+// Parse reads HTML from r and converts it into the elements of the package,
+// so the markup can be modified with the same API as any other element.
+//
+// Every tag is converted into its element type (Div into *DivElement, Input
+// into *InputElement, ...) and the unknown tags into *CustomElement. The
+// attributes, classes, styles and text are kept; the text is escaped again
+// when rendered. Comments are kept as RawString and the doctype is dropped,
+// as Html already renders it.
+//
+// Inside <svg> and <math>, the tags are converted into the SVG and MathML
+// elements, like SvgPath or Mfrac, the case of the SVG tag and attribute
+// names is restored, and self-closing tags, like <circle/>, are closed.
+//
+// Like browsers, Parse accepts malformed HTML: the omitted end tags are
+// implied and the end tags that don't match an open element are ignored. It
+// only returns the errors of r.
+func Parse(r io.Reader) (*UntaggedElement, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseString(string(b)), nil
+}
+
+// ParseString converts the HTML text s into the elements of the package.
+// See Parse for more details.
+//
+// Example:
+//
+//	article := ParseString(cms.Body(id))
+//	Div(article).Class("article")
+func ParseString(s string) *UntaggedElement {
+	root := Container()
+	p := &parser{s: s, stack: []*element{root.element}}
+	p.parse()
+	return root
+}
+
+func (p *parser) current() *element {
+	return p.stack[len(p.stack)-1]
+}
+
+func (p *parser) parse() {
+	for p.s != "" {
+		i := strings.IndexByte(p.s, '<')
+		if i < 0 {
+			p.addText(p.s)
+			return
+		}
+		if i > 0 {
+			p.addText(p.s[:i])
+			p.s = p.s[i:]
+		}
+
+		switch rest := p.s[1:]; {
+		case strings.HasPrefix(rest, "!--"):
+			p.parseComment()
+		case strings.HasPrefix(rest, "!"), strings.HasPrefix(rest, "?"):
+			// doctype, CDATA or processing instruction
+			p.s = p.s[skipTag(p.s):]
+		case strings.HasPrefix(rest, "/") && len(rest) > 1 && isASCIILetter(rest[1]):
+			p.parseEndTag()
+		case rest != "" && isASCIILetter(rest[0]):
+			p.parseStartTag()
+		default:
+			p.addText("<")
+			p.s = rest
+		}
+	}
+}
+
+// addText adds the text s, with its character references decoded.
+func (p *parser) addText(s string) {
+	if s == "" {
+		return
+	}
+
+	el := p.current()
+	if n := len(el.content); n > 0 {
+		// joins the text split by a "<" that doesn't start a tag
+		if t, ok := el.content[n-1].(*textEntity); ok && t.context == textContext {
+			t.content += html.UnescapeString(s)
+			return
+		}
+	}
+	el.content = append(el.content, &textEntity{textContext, html.UnescapeString(s)})
+}
+
+func (p *parser) parseComment() {
+	end := strings.Index(p.s[4:], "-->")
+	if end < 0 {
+		p.current().content = append(p.current().content, rawString("%s-->", p.s))
+		p.s = ""
+		return
+	}
+
+	end += 4 + len("-->")
+	p.current().content = append(p.current().content, rawString("%s", p.s[:end]))
+	p.s = p.s[end:]
+}
+
+func (p *parser) parseEndTag() {
+	end := skipTag(p.s)
+	name := strings.ToLower(tagName(p.s[2:end]))
+	p.s = p.s[end:]
+
+	for i := len(p.stack) - 1; i > 0; i-- {
+		if strings.EqualFold(p.stack[i].tag, name) {
+			p.stack = p.stack[:i]
+			return
+		}
+	}
+}
+
+func (p *parser) parseStartTag() {
+	end := skipTag(p.s)
+	tag := strings.TrimSuffix(p.s[1:end], ">")
+	p.s = p.s[end:]
+
+	name := tagName(tag)
+	raw := strings.TrimSpace(tag[len(name):])
+	selfClosing := selfClosingTag(raw)
+	if selfClosing {
+		raw = strings.TrimSpace(raw[:len(raw)-1])
+	}
+	name = strings.ToLower(name)
+
+	foreign := p.foreignContent()
+	if foreign == nil {
+		p.closeImplied(name)
+	}
+
+	node := foreign.newNode(name)
+	el := node.(elementer).elem()
+	switch el.tag {
+	case "svg":
+		foreign = svgContent
+	case "math":
+		foreign = mathContent
+	}
+	p.addAttributes(el, raw, foreign)
+	p.current().content = append(p.current().content, node)
+
+	if !el.hasClosingTag || el.foreign && selfClosing {
+		return
+	}
+
+	if rawTextElements[name] && !el.foreign {
+		p.parseRawText(el)
+		return
+	}
+
+	p.stack = append(p.stack, el)
+}
+
+// selfClosingTag reports whether the attributes of a start tag end with the
+// "/" of a self-closing tag. A "/" at the end of an unquoted value, like in
+// <a href=/docs/>, is part of the value.
+func selfClosingTag(raw string) bool {
+	s := raw
+	for {
+		s = strings.TrimLeft(s, " \t\n\r\f")
+		switch {
+		case s == "":
+			return false
+		case s == "/":
+			return true
+		case s[0] == '/':
+			s = s[1:]
+			continue
+		}
+
+		i := strings.IndexAny(s[1:], " \t\n\r\f=/") + 1
+		if i == 0 {
+			return false
+		}
+		s = strings.TrimLeft(s[i:], " \t\n\r\f")
+		if !strings.HasPrefix(s, "=") {
+			continue
+		}
+
+		s = strings.TrimLeft(s[1:], " \t\n\r\f")
+		switch {
+		case s == "":
+			return false
+		case s[0] == '"' || s[0] == '\'':
+			end := strings.IndexByte(s[1:], s[0])
+			if end < 0 {
+				return false
+			}
+			s = s[end+2:]
+		default:
+			end := strings.IndexAny(s, " \t\n\r\f")
+			if end < 0 {
+				return false
+			}
+			s = s[end:]
+		}
+	}
+}
+
+// foreignContent returns the foreign content the current element is in, or
+// nil when its content is HTML.
+func (p *parser) foreignContent() *foreignContent {
+	if el := p.current(); !el.foreign || htmlIntegrationPoints[el.tag] {
+		return nil
+	}
+
+	for i := len(p.stack) - 1; i > 0; i-- {
+		switch p.stack[i].tag {
+		case "svg":
+			return svgContent
+		case "math":
+			return mathContent
+		}
+	}
+	return nil
+}
+
+// newNode returns the element of the tag name. In foreign content, the
+// unknown tags are kept as foreign elements, with their case restored.
+func (f *foreignContent) newNode(name string) fmt.Stringer {
+	if f == nil {
+		if ctor, ok := parseConstructors[name]; ok {
+			return ctor()
+		}
+		return newCustomElement(name)
+	}
+
+	if ctor, ok := f.constructors[name]; ok {
+		return ctor()
+	}
+	if n, ok := f.tagNames[name]; ok {
+		name = n
+	}
+	c := newCustomElement(name)
+	c.foreign = true
+	return c
+}
+
+// closeImplied closes the open elements whose end tag is implied by the
+// start tag of name.
+func (p *parser) closeImplied(name string) {
+	if closePElement[name] && p.current().tag == "p" {
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+
+	tags := impliedEndTags[name]
+	for len(p.stack) > 1 && slices.Contains(tags, p.current().tag) {
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+}
+
+// addAttributes adds the attributes written in s to el. The class and style
+// attributes are added as classes and styles. When an attribute is repeated,
+// the first value is kept, as browsers do. The attributes added by the
+// constructor of el, like the namespace of <svg>, are replaced by those of s.
+// In foreign content, the case of the attribute names is restored.
+func (p *parser) addAttributes(el *element, s string, foreign *foreignContent) {
+	attrs, _ := parseAttributes(s)
+
+	el.attributes = nil
+	seen := make(map[string]bool, len(attrs))
+	for _, a := range attrs {
+		a.name = strings.ToLower(a.name)
+		if foreign != nil {
+			if n, ok := foreign.attributeNames[a.name]; ok {
+				a.name = n
+			}
+		}
+		if seen[a.name] {
+			continue
+		}
+		seen[a.name] = true
+
+		switch a.name {
+		case "class":
+			el.addClasses(strings.Fields(a.value)...)
+		case "style":
+			el.addStyles(a.value)
+		default:
+			el.attributes = append(el.attributes, a)
+		}
+	}
+}
+
+// parseRawText adds the content of the raw text element el, up to its end
+// tag.
+func (p *parser) parseRawText(el *element) {
+	end := indexEndTag(p.s, el.tag)
+	text := p.s[:end]
+	p.s = p.s[end:]
+	if p.s != "" {
+		p.s = p.s[skipTag(p.s):]
+	}
+
+	if text == "" {
+		return
+	}
+
+	switch el.tag {
+	case "script":
+		el.content = append(el.content, &textEntity{scriptContext, text})
+	case "style":
+		el.content = append(el.content, &textEntity{styleContext, text})
+	default:
+		el.content = append(el.content, &textEntity{textContext, html.UnescapeString(text)})
+	}
+}
+
+// skipTag returns the position after the ">" that ends the tag at the
+// beginning of s. The ">" inside quoted attribute values are skipped.
+func skipTag(s string) int {
+	var quote byte
+	var afterEquals bool
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			continue
+		case (c == '"' || c == '\'') && afterEquals:
+			quote = c
+		case c == '>':
+			return i + 1
+		}
+
+		if !strings.ContainsRune(" \t\n\r\f", rune(c)) {
+			afterEquals = c == '='
+		}
+	}
+	return len(s)
+}
+
+// tagName returns the name at the beginning of s.
+func tagName(s string) string {
+	i := strings.IndexAny(s, " \t\n\r\f/>")
+	if i < 0 {
+		return s
+	}
+	return s[:i]
+}
+
+// indexEndTag returns the position of the end tag of tag in s, or len(s) when
+// it is not found.
+func indexEndTag(s, tag string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '<' || i+2+len(tag) > len(s) || s[i+1] != '/' {
+			continue
+		}
+		if !strings.EqualFold(s[i+2:i+2+len(tag)], tag) {
+			continue
+		}
+		if next := i + 2 + len(tag); next == len(s) || strings.IndexByte(" \t\n\r\f/>", s[next]) >= 0 {
+			return i
+		}
+	}
+	return len(s)
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
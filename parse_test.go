@@ -0,0 +1,66 @@
+package renderHTML
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{"elements", `<div id="a" class="x  y" style="color: red; margin:0"><p>Hi <b>you</b></p></div>`,
+			`<div id="a" class="x y" style="color: red; margin:0;">` + `<p>Hi <b>you</b></p></div>`},
+		{"void", `<p>a<br>b<img src="/i.png" alt=''/></p><hr/>`, `<p>a<br/>b<img src="/i.png" alt=""/></p><hr/>`},
+		{"text", `a &lt; b &amp;&amp; c > d`, `a &lt; b &amp;&amp; c &gt; d`},
+		{"less than", `1 < 2 <3`, `1 &lt; 2 &lt;3`},
+		{"attribute", `<a href="/q?a=1&amp;b=2" title='say "hi"' hidden>x</a>`, `<a href="/q?a=1&amp;b=2" title="say &#34;hi&#34;" hidden>x</a>`},
+		{"quoted >", `<span title="a > b">x</span>`, `<span title="a &gt; b">x</span>`},
+		{"upper case", `<DIV Class="a">x</Div>`, `<div class="a">x</div>`},
+		{"unknown", `<my-card size="2"><p>x</p></my-card>`, `<my-card size="2"><p>x</p></my-card>`},
+		{"comment", `<p>a<!-- note --></p>`, `<p>a<!-- note --></p>`},
+		{"doctype", `<!DOCTYPE html><html><body>x</body></html>`, `<!DOCTYPE html><html><body>x</body></html>`},
+		{"script", `<script>if (a < b && c) {}</script>`, `<script>if (a < b && c) {}</script>`},
+		{"textarea", `<textarea><b>&amp;</b></textarea>`, `<textarea>&lt;b&gt;&amp;&lt;/b&gt;</textarea>`},
+		{"implied li", `<ul><li>a<li>b</ul>`, `<ul><li>a</li><li>b</li></ul>`},
+		{"implied p", `<p>a<div>b</div>`, `<p>a</p><div>b</div>`},
+		{"implied td", `<table><tr><td>a<td>b<tr><td>c</table>`, `<table><tr><td>a</td><td>b</td></tr><tr><td>c</td></tr></table>`},
+		{"stray end tag", `<div>a</span>b</div>`, `<div>ab</div>`},
+		{"unclosed", `<div><p>a`, `<div><p>a</p></div>`},
+		{"invalid attribute", `<div a"b=1 id=x>y</div>`, `<div>y</div>`},
+		{"unquoted slash", `<a href=/docs/>x</a><a href=/ >y</a>`, `<a href="/docs/">x</a><a href="/">y</a>`},
+		{"self-closing", `<p><input disabled/><img src=a.png /></p>`, `<p><input disabled/><img src="a.png"/></p>`},
+	}
+
+	for _, tt := range tests {
+		if got := ParseString(tt.html).String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseTypes(t *testing.T) {
+	root, err := Parse(strings.NewReader(`<div><input type="text" name="q"><my-card></my-card></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	div, ok := root.content[0].(*DivElement)
+	if !ok {
+		t.Fatalf("got %T, want *DivElement", root.content[0])
+	}
+	if _, ok := div.content[0].(*InputElement); !ok {
+		t.Errorf("got %T, want *InputElement", div.content[0])
+	}
+	if _, ok := div.content[1].(*CustomElement); !ok {
+		t.Errorf("got %T, want *CustomElement", div.content[1])
+	}
+
+	div.Class("parsed").AddContent(P("new"))
+	want := `<div class="parsed"><input type="text" name="q"/><my-card></my-card><p>new</p></div>`
+	if got := root.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,671 @@
+package renderHTML
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// #region QUERIES
+
+// The queries search the descendants of an element, in document order. The
+// elements without tags, like Container, are transparent: their content is
+// searched as if it was included directly in their parent.
+//
+// The found elements are returned with their own type, so they can be
+// modified after a type assertion:
+//
+//	if div, ok := page.ById("total").(*DivElement); ok {
+//		div.AddContent(total)
+//	}
+
+// ById returns the first descendant of the element whose id is id, or nil if
+// there is none.
+func (p *element) ById(id string) fmt.Stringer {
+	var found fmt.Stringer
+	p.walk(func(n node) bool {
+		if v, ok := n.el.attributeValue("id"); ok && v == id {
+			found = n.value
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// ByClass returns the descendants of the element that have the class.
+func (p *element) ByClass(class string) []fmt.Stringer {
+	var found []fmt.Stringer
+	p.walk(func(n node) bool {
+		if n.el.hasClass(class) {
+			found = append(found, n.value)
+		}
+		return true
+	})
+	return found
+}
+
+// ByTag returns the descendants of the element with the tag name, like "div"
+// or "input".
+func (p *element) ByTag(tag string) []fmt.Stringer {
+	var found []fmt.Stringer
+	p.walk(func(n node) bool {
+		if strings.EqualFold(n.el.tag, tag) {
+			found = append(found, n.value)
+		}
+		return true
+	})
+	return found
+}
+
+// Query returns the first descendant of the element matched by the CSS
+// selector, or nil if there is none. See QueryAll for the supported
+// selectors.
+func (p *element) Query(selector string) (fmt.Stringer, error) {
+	sel, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var found fmt.Stringer
+	p.walkPath(func(path []step) bool {
+		if sel.matches(path) {
+			found = path[len(path)-1].value
+			return false
+		}
+		return true
+	})
+	return found, nil
+}
+
+// QueryAll returns the descendants of the element matched by the CSS
+// selector. It returns an error if the selector is not valid.
+//
+// The supported selectors are:
+//
+//   - type (div), universal (*), id (#main) and class (.card) selectors.
+//   - attribute selectors: [name], [name=value], [name~=value],
+//     [name|=value], [name^=value], [name$=value] and [name*=value].
+//   - the pseudo-classes :nth-child(an+b), :nth-last-child(an+b),
+//     :first-child, :last-child, :only-child and :not(selector).
+//   - the descendant ( ), child (>), next-sibling (+) and subsequent-sibling
+//     (~) combinators, and selector lists (h1, h2).
+//
+// The element itself can be matched by the ancestors in the selector, but
+// not by the pseudo-classes that depend on its position, as its siblings are
+// unknown.
+func (p *element) QueryAll(selector string) ([]fmt.Stringer, error) {
+	sel, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []fmt.Stringer
+	p.walkPath(func(path []step) bool {
+		if sel.matches(path) {
+			found = append(found, path[len(path)-1].value)
+		}
+		return true
+	})
+	return found, nil
+}
+
+// #region tree
+
+// node is an element of the tree and the value it was added as, which is one
+// of the element types.
+type node struct {
+	value fmt.Stringer
+	el    *element
+}
+
+// children returns the elements of the content, including the content of
+// the elements without tags.
+func (p *element) children() []node {
+	var list []node
+	for _, c := range p.content {
+		e, ok := c.(elementer)
+		if !ok {
+			continue
+		}
+
+		el := e.elem()
+		if el.tag == "" {
+			list = append(list, el.children()...)
+			continue
+		}
+		list = append(list, node{c, el})
+	}
+	return list
+}
+
+// walk calls f for every descendant in document order, until it returns
+// false.
+func (p *element) walk(f func(n node) bool) bool {
+	for _, n := range p.children() {
+		if !f(n) || !n.el.walk(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// step is an element of the path from the root of a query to an element.
+// The siblings of the root are unknown, as elements don't keep their parent,
+// so its siblings are nil and its index is -1.
+type step struct {
+	node
+	siblings []node
+	index    int
+}
+
+// walkPath calls f with the path of every descendant in document order,
+// until it returns false. The path includes the element itself when it has a
+// tag, so it can be matched by the ancestors in the selectors.
+func (p *element) walkPath(f func(path []step) bool) {
+	var path []step
+	if p.tag != "" {
+		path = append(path, step{node{p, p}, nil, -1})
+	}
+
+	var walk func(el *element) bool
+	walk = func(el *element) bool {
+		children := el.children()
+		for i, n := range children {
+			path = append(path, step{n, children, i})
+			if !f(path) || !walk(n.el) {
+				return false
+			}
+			path = path[:len(path)-1]
+		}
+		return true
+	}
+	walk(p)
+}
+
+// hasClass reports whether the element has the class.
+func (p *element) hasClass(class string) bool {
+	return slices.Contains(p.classes, class)
+}
+
+// attributeValue returns the value of the attribute, including class and
+// style, and whether the element has it.
+func (p *element) attributeValue(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "class":
+		return strings.Join(p.classes, " "), len(p.classes) > 0
+	case "style":
+		return strings.Join(p.styles, " "), len(p.styles) > 0
+	}
+
+	for _, a := range p.attributes {
+		if strings.EqualFold(a.name, name) {
+			return a.value, true
+		}
+	}
+	return "", false
+}
+
+// #region selectors
+
+// selectorList is a list of selectors separated by commas. It matches the
+// elements matched by any of them.
+type selectorList []complexSelector
+
+// complexSelector is a sequence of compound selectors joined by combinators:
+// combinators[i] is between compounds[i] and compounds[i+1].
+type complexSelector struct {
+	compounds   []compoundSelector
+	combinators []byte
+}
+
+// compoundSelector is a sequence of simple selectors that match the same
+// element, like "div.card[hidden]".
+type compoundSelector struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   []attrSelector
+	pseudos []pseudoSelector
+}
+
+type attrSelector struct {
+	name  string
+	op    string
+	value string
+}
+
+type pseudoSelector struct {
+	name string
+	a, b int
+	not  selectorList
+}
+
+func (s selectorList) matches(path []step) bool {
+	for _, c := range s {
+		if c.matchesAt(len(c.compounds)-1, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAt reports whether the compounds up to k match the last element of
+// path and its ancestors or siblings.
+func (s complexSelector) matchesAt(k int, path []step) bool {
+	last := len(path) - 1
+	if !s.compounds[k].matches(path[last]) {
+		return false
+	}
+	if k == 0 {
+		return true
+	}
+
+	cur := path[last]
+	switch s.combinators[k-1] {
+	case ' ':
+		for i := last - 1; i >= 0; i-- {
+			if s.matchesAt(k-1, path[:i+1]) {
+				return true
+			}
+		}
+	case '>':
+		return last > 0 && s.matchesAt(k-1, path[:last])
+	case '+':
+		if cur.index > 0 {
+			return s.matchesAt(k-1, siblingPath(path, cur.index-1))
+		}
+	case '~':
+		for i := cur.index - 1; i >= 0; i-- {
+			if s.matchesAt(k-1, siblingPath(path, i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// siblingPath returns the path of the sibling i of the last element of path.
+func siblingPath(path []step, i int) []step {
+	cur := path[len(path)-1]
+	sibling := make([]step, len(path))
+	copy(sibling, path)
+	sibling[len(path)-1] = step{cur.siblings[i], cur.siblings, i}
+	return sibling
+}
+
+func (s compoundSelector) matches(st step) bool {
+	el := st.el
+	if s.tag != "" && s.tag != "*" && !strings.EqualFold(el.tag, s.tag) {
+		return false
+	}
+	if s.id != "" {
+		if v, ok := el.attributeValue("id"); !ok || v != s.id {
+			return false
+		}
+	}
+
+	for _, c := range s.classes {
+		if !el.hasClass(c) {
+			return false
+		}
+	}
+
+	for _, a := range s.attrs {
+		if !a.matches(el) {
+			return false
+		}
+	}
+
+	for _, p := range s.pseudos {
+		if !p.matches(st) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s attrSelector) matches(el *element) bool {
+	v, ok := el.attributeValue(s.name)
+	if !ok {
+		return false
+	}
+
+	switch s.op {
+	case "":
+		return true
+	case "=":
+		return v == s.value
+	case "~=":
+		for _, f := range strings.Fields(v) {
+			if f == s.value {
+				return true
+			}
+		}
+		return false
+	case "|=":
+		return v == s.value || strings.HasPrefix(v, s.value+"-")
+	case "^=":
+		return s.value != "" && strings.HasPrefix(v, s.value)
+	case "$=":
+		return s.value != "" && strings.HasSuffix(v, s.value)
+	case "*=":
+		return s.value != "" && strings.Contains(v, s.value)
+	}
+	return false
+}
+
+func (s pseudoSelector) matches(st step) bool {
+	if st.siblings == nil && s.name != "not" {
+		// the position of the root is unknown
+		return false
+	}
+
+	switch s.name {
+	case "nth-child":
+		return nth(s.a, s.b, st.index+1)
+	case "nth-last-child":
+		return nth(s.a, s.b, len(st.siblings)-st.index)
+	case "first-child":
+		return st.index == 0
+	case "last-child":
+		return st.index == len(st.siblings)-1
+	case "only-child":
+		return len(st.siblings) == 1
+	case "not":
+		return !s.not.matches([]step{st})
+	}
+	return false
+}
+
+// nth reports whether the position pos (starting at 1) is a*n+b for some
+// n >= 0.
+func nth(a, b, pos int) bool {
+	if a == 0 {
+		return pos == b
+	}
+	d := pos - b
+	return d/a >= 0 && d%a == 0
+}
+
+// #region selector parser
+
+// selectorParser parses CSS selectors. It only supports the selectors
+// listed in QueryAll.
+type selectorParser struct {
+	s   string
+	pos int
+}
+
+func parseSelector(s string) (selectorList, error) {
+	p := &selectorParser{s: s}
+	list, err := p.parseList()
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector %q: %v", s, err)
+	}
+	if p.pos < len(p.s) {
+		return nil, fmt.Errorf("invalid selector %q: unexpected %q", s, p.s[p.pos:])
+	}
+	return list, nil
+}
+
+func (p *selectorParser) parseList() (selectorList, error) {
+	var list selectorList
+	for {
+		p.skipSpace()
+		c, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+
+		p.skipSpace()
+		if !p.consume(',') {
+			return list, nil
+		}
+	}
+}
+
+func (p *selectorParser) parseComplex() (complexSelector, error) {
+	var c complexSelector
+	for {
+		compound, err := p.parseCompound()
+		if err != nil {
+			return c, err
+		}
+		c.compounds = append(c.compounds, compound)
+
+		hasSpace := p.skipSpace()
+		if p.pos == len(p.s) {
+			return c, nil
+		}
+
+		switch ch := p.s[p.pos]; ch {
+		case '>', '+', '~':
+			p.pos++
+			p.skipSpace()
+			c.combinators = append(c.combinators, ch)
+		case ',', ')':
+			return c, nil
+		default:
+			if !hasSpace {
+				return c, fmt.Errorf("unexpected %q", p.s[p.pos:])
+			}
+			c.combinators = append(c.combinators, ' ')
+		}
+	}
+}
+
+func (p *selectorParser) parseCompound() (compoundSelector, error) {
+	var c compoundSelector
+	start := p.pos
+
+	if p.consume('*') {
+		c.tag = "*"
+	} else if name := p.parseIdent(); name != "" {
+		c.tag = name
+	}
+
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case '#':
+			p.pos++
+			if c.id = p.parseIdent(); c.id == "" {
+				return c, fmt.Errorf("missing id after #")
+			}
+		case '.':
+			p.pos++
+			class := p.parseIdent()
+			if class == "" {
+				return c, fmt.Errorf("missing class after .")
+			}
+			c.classes = append(c.classes, class)
+		case '[':
+			p.pos++
+			a, err := p.parseAttr()
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, a)
+		case ':':
+			p.pos++
+			ps, err := p.parsePseudo()
+			if err != nil {
+				return c, err
+			}
+			c.pseudos = append(c.pseudos, ps)
+		default:
+			if p.pos == start {
+				return c, fmt.Errorf("unexpected %q", p.s[p.pos:])
+			}
+			return c, nil
+		}
+	}
+
+	if p.pos == start {
+		return c, fmt.Errorf("missing selector")
+	}
+	return c, nil
+}
+
+func (p *selectorParser) parseAttr() (attrSelector, error) {
+	var a attrSelector
+
+	p.skipSpace()
+	if a.name = p.parseIdent(); a.name == "" {
+		return a, fmt.Errorf("missing attribute name")
+	}
+	p.skipSpace()
+
+	if p.consume(']') {
+		return a, nil
+	}
+
+	for _, op := range []string{"=", "~=", "|=", "^=", "$=", "*="} {
+		if strings.HasPrefix(p.s[p.pos:], op) {
+			a.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if a.op == "" {
+		return a, fmt.Errorf("invalid attribute selector")
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.s) && (p.s[p.pos] == '"' || p.s[p.pos] == '\'') {
+		quote := p.s[p.pos]
+		end := strings.IndexByte(p.s[p.pos+1:], quote)
+		if end < 0 {
+			return a, fmt.Errorf("unterminated string")
+		}
+		a.value = p.s[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+	} else if a.value = p.parseIdent(); a.value == "" {
+		return a, fmt.Errorf("missing value of attribute %q", a.name)
+	}
+
+	p.skipSpace()
+	if !p.consume(']') {
+		return a, fmt.Errorf("missing ]")
+	}
+	return a, nil
+}
+
+func (p *selectorParser) parsePseudo() (pseudoSelector, error) {
+	ps := pseudoSelector{name: strings.ToLower(p.parseIdent())}
+
+	switch ps.name {
+	case "first-child", "last-child", "only-child":
+		return ps, nil
+	case "nth-child", "nth-last-child", "not":
+	default:
+		return ps, fmt.Errorf("unsupported pseudo-class :%s", ps.name)
+	}
+
+	if !p.consume('(') {
+		return ps, fmt.Errorf("missing ( after :%s", ps.name)
+	}
+
+	var err error
+	if ps.name == "not" {
+		ps.not, err = p.parseList()
+	} else {
+		end := strings.IndexByte(p.s[p.pos:], ')')
+		if end < 0 {
+			return ps, fmt.Errorf("missing )")
+		}
+		ps.a, ps.b, err = parseNth(p.s[p.pos : p.pos+end])
+		p.pos += end
+	}
+	if err != nil {
+		return ps, err
+	}
+
+	p.skipSpace()
+	if !p.consume(')') {
+		return ps, fmt.Errorf("missing )")
+	}
+	return ps, nil
+}
+
+// parseNth parses the argument of :nth-child: "odd", "even", "3", "2n+1",
+// "-n+3", ...
+func parseNth(s string) (a, b int, err error) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), ""))
+	switch s {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	}
+
+	i := strings.IndexByte(s, 'n')
+	if i < 0 {
+		b, err = strconv.Atoi(s)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid :nth-child argument %q", s)
+		}
+		return 0, b, nil
+	}
+
+	switch s[:i] {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		if a, err = strconv.Atoi(s[:i]); err != nil {
+			return 0, 0, fmt.Errorf("invalid :nth-child argument %q", s)
+		}
+	}
+
+	if rest := s[i+1:]; rest != "" {
+		if rest[0] != '+' && rest[0] != '-' {
+			return 0, 0, fmt.Errorf("invalid :nth-child argument %q", s)
+		}
+		if b, err = strconv.Atoi(rest); err != nil {
+			return 0, 0, fmt.Errorf("invalid :nth-child argument %q", s)
+		}
+	}
+	return a, b, nil
+}
+
+// parseIdent parses a CSS identifier. Backslash escapes the next character.
+func (p *selectorParser) parseIdent() string {
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.s):
+			b.WriteByte(p.s[p.pos+1])
+			p.pos += 2
+		case c == '-' || c == '_' || c >= 0x80 ||
+			'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9':
+			b.WriteByte(c)
+			p.pos++
+		default:
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// skipSpace skips the whitespace and reports whether there was any.
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(" \t\n\r\f", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *selectorParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package renderHTML
+
+import (
+	"fmt"
+	"testing"
+)
+
+func queryPage() *HtmlElement {
+	return Html(Body(
+		Nav(Ul(
+			Li(A("Home").Href("/")).Class("active"),
+			Li(A("Docs").Href("/docs")),
+			Li(A("Mail").Href("mailto:a@b.c")),
+		)).Id("menu"),
+		Container(
+			Div(P("one").Class("intro lead"), P("two")).Id("main").Class("content"),
+			Div(Input().Type("text").Name("q").Required()).Data("role", "search"),
+		),
+		P("footer").Class("small"),
+	))
+}
+
+func strs(list []fmt.Stringer) []string {
+	var s []string
+	for _, v := range list {
+		s = append(s, v.String())
+	}
+	return s
+}
+
+func TestQuery(t *testing.T) {
+	page := queryPage()
+
+	if div, ok := page.ById("main").(*DivElement); !ok {
+		t.Errorf("ById: got %T, want *DivElement", page.ById("main"))
+	} else if got, want := div.String(), `<div id="main" class="content"><p class="intro lead">one</p><p>two</p></div>`; got != want {
+		t.Errorf("ById: got %v, want %v", got, want)
+	}
+	if got := page.ById("none"); got != nil {
+		t.Errorf("ById: got %v, want nil", got)
+	}
+	if got := len(page.ByTag("p")); got != 3 {
+		t.Errorf("ByTag: got %v elements, want 3", got)
+	}
+	if got := strs(page.ByClass("lead")); len(got) != 1 || got[0] != `<p class="intro lead">one</p>` {
+		t.Errorf("ByClass: got %v", got)
+	}
+
+	tests := []struct {
+		selector string
+		want     []string
+	}{
+		{"#menu li.active > a", []string{`<a href="/">Home</a>`}},
+		{"ul > li:nth-child(2) a", []string{`<a href="/docs">Docs</a>`}},
+		{"li:nth-child(odd) a", []string{`<a href="/">Home</a>`, `<a href="mailto:a@b.c">Mail</a>`}},
+		{"li:last-child > a", []string{`<a href="mailto:a@b.c">Mail</a>`}},
+		{"a[href^=mailto]", []string{`<a href="mailto:a@b.c">Mail</a>`}},
+		{`a[href="/docs"], p.small`, []string{`<a href="/docs">Docs</a>`, `<p class="small">footer</p>`}},
+		{"[data-role=search] input[required]", []string{`<input type="text" name="q" required/>`}},
+		{"body > div:nth-child(2)", []string{`<div id="main" class="content"><p class="intro lead">one</p><p>two</p></div>`}},
+		{"div + div input", []string{`<input type="text" name="q" required/>`}},
+		{"nav ~ p", []string{`<p class="small">footer</p>`}},
+		{"#main p:not(.intro)", []string{`<p>two</p>`}},
+		{"p[class~=lead]", []string{`<p class="intro lead">one</p>`}},
+		{"section", nil},
+	}
+
+	for _, tt := range tests {
+		got, err := page.QueryAll(tt.selector)
+		if err != nil {
+			t.Errorf("%v: %v", tt.selector, err)
+			continue
+		}
+		if fmt.Sprint(strs(got)) != fmt.Sprint(tt.want) {
+			t.Errorf("%v: got %v, want %v", tt.selector, strs(got), tt.want)
+		}
+	}
+
+	if got, _ := page.Query("li"); got == nil || got.String() != `<li class="active"><a href="/">Home</a></li>` {
+		t.Errorf("Query: got %v", got)
+	}
+
+	// the position of the queried element among its siblings is unknown
+	main := page.ById("main").(*DivElement)
+	if got, _ := main.QueryAll("div:first-child > p, div:only-child > p"); got != nil {
+		t.Errorf("root: got %v, want nil", strs(got))
+	}
+	if got, _ := main.QueryAll("div > p:first-child"); len(got) != 1 {
+		t.Errorf("root: got %v, want one element", strs(got))
+	}
+
+	for _, selector := range []string{"", "div >", "[href", "a:hover", "li:nth-child(x)", "a..b"} {
+		if _, err := page.QueryAll(selector); err == nil {
+			t.Errorf("%q: want error", selector)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+package renderHTML
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// #region RENDERER
+
+// renderer writes elements to an io.Writer. It keeps the first write error
+// and the number of bytes written, so the rendering code doesn't need to
+// check every write.
+type renderer struct {
+	w   io.Writer
+	n   int64
+	err error
+
+	// indent is written once per depth level before the elements that are
+	// rendered on their own lines. When it is empty, everything is rendered
+	// in a single line.
+	indent string
+	depth  int
+
+	// ctx is given to the components.
+	ctx context.Context
+}
+
+func newRenderer(w io.Writer) *renderer {
+	return &renderer{w: w, ctx: context.Background()}
+}
+
+// Write implements io.Writer.
+func (r *renderer) Write(b []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err := r.w.Write(b)
+	r.n += int64(n)
+	r.err = err
+	return n, err
+}
+
+// WriteString implements io.StringWriter.
+func (r *renderer) WriteString(s string) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err := io.WriteString(r.w, s)
+	r.n += int64(n)
+	r.err = err
+	return n, err
+}
+
+func (r *renderer) writeString(s string) {
+	r.WriteString(s)
+}
+
+// writeEscaped writes s escaped for the given context.
+func (r *renderer) writeEscaped(ctx escapeContext, s string) {
+	switch ctx {
+	case scriptContext:
+		r.writeString(escapeRawText(s, "script"))
+	case styleContext:
+		r.writeString(escapeRawText(s, "style"))
+	case urlContext:
+		htmlEscaper.WriteString(r, sanitizeURL(s))
+	default:
+		htmlEscaper.WriteString(r, s)
+	}
+}
+
+// renderable is implemented by the values that can write themselves to a
+// renderer. Any other fmt.Stringer is written using its String method.
+type renderable interface {
+	render(r *renderer)
+}
+
+// renderNode writes any content of an element.
+func (r *renderer) renderNode(node fmt.Stringer) {
+	if n, ok := node.(renderable); ok {
+		n.render(r)
+		return
+	}
+	r.writeString(node.String())
+}
+
+// renderString renders node into a string.
+func renderString(node renderable) string {
+	var s strings.Builder
+	node.render(newRenderer(&s))
+	return s.String()
+}
+
+// #region element
+
+func (p *element) render(r *renderer) {
+	if p.tag == "" {
+		// it is only used for UntaggedElement
+		if r.indent == "" {
+			p.renderContent(r)
+			return
+		}
+
+		content := r.renderComponents(p.content)
+		if !blockContent(content) {
+			r.renderNodes(content)
+			return
+		}
+		for i, node := range content {
+			if i > 0 {
+				r.newLine()
+			}
+			r.renderNode(node)
+		}
+		return
+	}
+
+	r.writeString("<")
+	r.writeString(p.tag)
+	p.renderAttributes(r)
+
+	if !p.hasClosingTag || p.foreign && len(p.content) == 0 {
+		r.writeString("/>")
+		return
+	}
+
+	r.writeString(">")
+	if r.indent != "" {
+		p.renderIndentedContent(r)
+	} else {
+		p.renderContent(r)
+	}
+	r.writeString("</")
+	r.writeString(p.tag)
+	r.writeString(">")
+}
+
+func (p *element) renderAttributes(r *renderer) {
+	for _, a := range p.attributes {
+		r.writeString(" ")
+		r.writeString(a.name)
+		if a.hasValue {
+			r.writeString(`="`)
+			r.writeEscaped(attributeValueContext(a.name), a.value)
+			r.writeString(`"`)
+		}
+	}
+
+	if len(p.classes) > 0 {
+		r.writeString(` class="`)
+		for i, c := range p.classes {
+			if i > 0 {
+				r.writeString(" ")
+			}
+			r.writeEscaped(attributeContext, c)
+		}
+		r.writeString(`"`)
+	}
+
+	if len(p.styles) > 0 {
+		r.writeString(` style="`)
+		for i, s := range p.styles {
+			if i > 0 {
+				r.writeString(" ")
+			}
+			r.writeEscaped(attributeContext, s)
+		}
+		r.writeString(`"`)
+	}
+}
+
+func (p *element) renderContent(r *renderer) {
+	r.renderNodes(p.content)
+}
+
+// Render writes the HTML text of the current element to w. The content is
+// streamed as it is produced, without building intermediate strings.
+func (p *element) Render(w io.Writer) error {
+	r := newRenderer(w)
+	p.render(r)
+	return r.err
+}
+
+// WriteTo writes the HTML text of the current element to w. It implements
+// io.WriterTo.
+func (p *element) WriteTo(w io.Writer) (int64, error) {
+	r := newRenderer(w)
+	p.render(r)
+	return r.n, r.err
+}
+
+// RenderContext writes the HTML text of the current element to w, like
+// Render, giving ctx to the components it contains.
+func (p *element) RenderContext(ctx context.Context, w io.Writer) error {
+	r := newRenderer(w)
+	r.ctx = ctx
+	p.render(r)
+	return r.err
+}
+
+// RenderStrict writes the HTML text of the current element to w, like Render,
+// only when the element and its descendants have no errors. Otherwise it
+// writes nothing and returns the errors joined. The errors are only recorded
+// in Strict mode.
+func (p *element) RenderStrict(w io.Writer) error {
+	if errs := p.Errors(); errs != nil {
+		return errors.Join(errs...)
+	}
+	return p.Render(w)
+}
+
+// #region indentation
+
+// blockElements are the elements rendered as blocks, or not rendered at all,
+// whose surrounding whitespace is ignored by the browser. Any other element,
+// including the custom, unknown and foreign ones, is rendered inline: a new
+// line before or after it would add a space to the page, so the content of
+// its parent is never indented.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "base": true,
+	"blockquote": true, "body": true, "caption": true, "col": true,
+	"colgroup": true, "dd": true, "details": true, "dialog": true, "div": true,
+	"dl": true, "dt": true, "fieldset": true, "figcaption": true,
+	"figure": true, "footer": true, "form": true, "h1": true, "h2": true,
+	"h3": true, "h4": true, "h5": true, "h6": true, "head": true,
+	"header": true, "hgroup": true, "hr": true, "html": true, "legend": true,
+	"li": true, "link": true, "main": true, "menu": true, "meta": true,
+	"nav": true, "ol": true, "optgroup": true, "option": true, "p": true,
+	"pre": true, "script": true, "search": true, "section": true,
+	"style": true, "summary": true, "table": true, "tbody": true, "td": true,
+	"template": true, "tfoot": true, "th": true, "thead": true, "title": true,
+	"tr": true, "ul": true,
+}
+
+// preformattedElements are the elements whose content is rendered exactly as
+// written, so it is never indented.
+var preformattedElements = map[string]bool{
+	"pre": true, "textarea": true, "script": true, "style": true,
+}
+
+// blockContent reports whether every node is an element that can be written
+// on its own line without changing how the page is rendered. The content of
+// untagged elements is checked as if it was included directly.
+func blockContent(content []fmt.Stringer) bool {
+	if len(content) == 0 {
+		return false
+	}
+
+	for _, node := range content {
+		e, ok := node.(elementer)
+		if !ok {
+			return false
+		}
+
+		el := e.elem()
+		switch {
+		case el.tag == "":
+			if !blockContent(el.content) {
+				return false
+			}
+		case !blockElements[el.tag]:
+			return false
+		}
+	}
+
+	return true
+}
+
+// renderComponents returns content with the components replaced by the views
+// they render, also inside untagged elements, so the indentation depends on
+// the elements they return. The content is returned as is when it has no
+// components.
+func (r *renderer) renderComponents(content []fmt.Stringer) []fmt.Stringer {
+	var list []fmt.Stringer
+	for i, node := range content {
+		view, ok := r.renderComponent(node)
+		if !ok {
+			if list != nil {
+				list = append(list, node)
+			}
+			continue
+		}
+
+		if list == nil {
+			list = append(make([]fmt.Stringer, 0, len(content)), content[:i]...)
+		}
+		if view != nil {
+			list = append(list, view)
+		}
+	}
+
+	if list == nil {
+		return content
+	}
+	return list
+}
+
+// renderComponent returns the view of node when it is a component, or an
+// untagged element with the views of the components it contains. It reports
+// false when node has no components.
+func (r *renderer) renderComponent(node fmt.Stringer) (fmt.Stringer, bool) {
+	switch n := node.(type) {
+	case *ComponentElement:
+		return n.view(r.ctx), true
+	case elementer:
+		el := n.elem()
+		if el.tag != "" {
+			return nil, false
+		}
+		content := r.renderComponents(el.content)
+		if len(content) == len(el.content) && (len(content) == 0 || &content[0] == &el.content[0]) {
+			return nil, false
+		}
+		return &element{content: content}, true
+	}
+	return nil, false
+}
+
+// renderNodes writes every node of the list.
+func (r *renderer) renderNodes(list []fmt.Stringer) {
+	for _, node := range list {
+		r.renderNode(node)
+	}
+}
+
+// newLine starts a new line indented for the current depth.
+func (r *renderer) newLine() {
+	r.writeString("\n")
+	for range r.depth {
+		r.writeString(r.indent)
+	}
+}
+
+// renderIndentedContent writes every child on its own line when the content
+// allows it. Otherwise the content, and all its descendants, are written in a
+// single line, as a new line would be rendered as a space.
+func (p *element) renderIndentedContent(r *renderer) {
+	content := r.renderComponents(p.content)
+	if !blockElements[p.tag] || preformattedElements[p.tag] || !blockContent(content) {
+		indent := r.indent
+		r.indent = ""
+		r.renderNodes(content)
+		r.indent = indent
+		return
+	}
+
+	r.depth++
+	for _, node := range content {
+		r.newLine()
+		r.renderNode(node)
+	}
+	r.depth--
+	r.newLine()
+}
+
+// RenderIndent writes the HTML text of the current element to w, with every
+// block element on its own line, indented with the given string (for example
+// "\t" or "  ") per level.
+//
+// Whitespace is only added where the browser ignores it, so the rendered page
+// doesn't change: the content of <pre>, <textarea>, <script> and <style>, and
+// of any element that contains text or inline elements such as <span>, <em>
+// or <a>, is written in a single line. The custom and unknown elements are
+// inline, like in the browser. The elements rendered by components are
+// indented like the others.
+func (p *element) RenderIndent(w io.Writer, indent string) error {
+	r := newRenderer(w)
+	r.indent = indent
+	p.render(r)
+	return r.err
+}
+
+// StringIndent returns the HTML text of the current element indented like
+// RenderIndent does. It is useful to debug the output or to compare it with
+// golden files.
+func (p *element) StringIndent(indent string) string {
+	var s strings.Builder
+	p.RenderIndent(&s, indent)
+	return s.String()
+}
+
+// #region texts
+
+func (p *textEntity) render(r *renderer) {
+	r.writeEscaped(p.context, p.content)
+}
+
+func (p *escapeStringEntity) render(r *renderer) {
+	htmlEscaper.WriteString(r, p.content)
+}
+
+func (p *rawStringEntity) render(r *renderer) {
+	r.writeString(p.content)
+}
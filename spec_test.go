@@ -0,0 +1,251 @@
+package renderHTML
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// specElements are the elements of the HTML living standard with their
+// attributes, without the global attributes and the event handlers. It also
+// has <fencedframe>, from the Fenced Frame draft.
+//
+// https://html.spec.whatwg.org/multipage/indices.html#elements-3
+var specElements = map[string][]string{
+	"a":               {"href", "target", "download", "ping", "rel", "hreflang", "type", "referrerpolicy"},
+	"abbr":            nil,
+	"address":         nil,
+	"area":            {"alt", "coords", "shape", "href", "target", "download", "ping", "rel", "referrerpolicy"},
+	"article":         nil,
+	"aside":           nil,
+	"audio":           {"src", "crossorigin", "preload", "autoplay", "loop", "muted", "controls"},
+	"b":               nil,
+	"base":            {"href", "target"},
+	"bdi":             nil,
+	"bdo":             nil,
+	"blockquote":      {"cite"},
+	"body":            nil,
+	"br":              nil,
+	"button":          {"command", "commandfor", "disabled", "form", "formaction", "formenctype", "formmethod", "formnovalidate", "formtarget", "name", "popovertarget", "popovertargetaction", "type", "value"},
+	"canvas":          {"width", "height"},
+	"caption":         nil,
+	"cite":            nil,
+	"code":            nil,
+	"col":             {"span"},
+	"colgroup":        {"span"},
+	"data":            {"value"},
+	"datalist":        nil,
+	"dd":              nil,
+	"del":             {"cite", "datetime"},
+	"details":         {"name", "open"},
+	"dfn":             nil,
+	"dialog":          {"closedby", "open"},
+	"div":             nil,
+	"dl":              nil,
+	"dt":              nil,
+	"em":              nil,
+	"embed":           {"src", "type", "width", "height"},
+	"fencedframe":     {"allow", "width", "height"},
+	"fieldset":        {"disabled", "form", "name"},
+	"figcaption":      nil,
+	"figure":          nil,
+	"footer":          nil,
+	"form":            {"accept-charset", "action", "autocomplete", "enctype", "method", "name", "novalidate", "rel", "target"},
+	"h1":              nil,
+	"h2":              nil,
+	"h3":              nil,
+	"h4":              nil,
+	"h5":              nil,
+	"h6":              nil,
+	"head":            nil,
+	"header":          nil,
+	"hgroup":          nil,
+	"hr":              nil,
+	"html":            nil,
+	"i":               nil,
+	"iframe":          {"src", "srcdoc", "name", "sandbox", "allow", "allowfullscreen", "width", "height", "referrerpolicy", "loading"},
+	"img":             {"alt", "src", "srcset", "sizes", "crossorigin", "usemap", "ismap", "width", "height", "referrerpolicy", "decoding", "loading", "fetchpriority"},
+	"input":           {"accept", "alpha", "alt", "autocomplete", "checked", "colorspace", "dirname", "disabled", "form", "formaction", "formenctype", "formmethod", "formnovalidate", "formtarget", "height", "list", "max", "maxlength", "min", "minlength", "multiple", "name", "pattern", "placeholder", "popovertarget", "popovertargetaction", "readonly", "required", "size", "src", "step", "type", "value", "width"},
+	"ins":             {"cite", "datetime"},
+	"kbd":             nil,
+	"label":           {"for"},
+	"legend":          nil,
+	"li":              {"value"},
+	"link":            {"href", "crossorigin", "rel", "as", "media", "hreflang", "type", "sizes", "imagesrcset", "imagesizes", "referrerpolicy", "integrity", "blocking", "color", "disabled", "fetchpriority"},
+	"main":            nil,
+	"map":             {"name"},
+	"mark":            nil,
+	"math":            nil,
+	"menu":            nil,
+	"meta":            {"name", "http-equiv", "content", "charset", "media"},
+	"meter":           {"value", "min", "max", "low", "high", "optimum"},
+	"nav":             nil,
+	"noscript":        nil,
+	"object":          {"data", "type", "name", "form", "width", "height"},
+	"ol":              {"reversed", "start", "type"},
+	"optgroup":        {"disabled", "label"},
+	"option":          {"disabled", "label", "selected", "value"},
+	"output":          {"for", "form", "name"},
+	"p":               nil,
+	"picture":         nil,
+	"pre":             nil,
+	"progress":        {"value", "max"},
+	"q":               {"cite"},
+	"rp":              nil,
+	"rt":              nil,
+	"ruby":            nil,
+	"s":               nil,
+	"samp":            nil,
+	"script":          {"src", "type", "nomodule", "async", "defer", "blocking", "crossorigin", "referrerpolicy", "integrity", "fetchpriority"},
+	"search":          nil,
+	"section":         nil,
+	"select":          {"autocomplete", "disabled", "form", "multiple", "name", "required", "size"},
+	"selectedcontent": nil,
+	"slot":            {"name"},
+	"small":           nil,
+	"source":          {"type", "media", "src", "srcset", "sizes", "width", "height"},
+	"span":            nil,
+	"strong":          nil,
+	"style":           {"media", "blocking"},
+	"sub":             nil,
+	"summary":         nil,
+	"sup":             nil,
+	"svg":             nil,
+	"table":           nil,
+	"tbody":           nil,
+	"td":              {"colspan", "rowspan", "headers"},
+	"template":        {"shadowrootmode", "shadowrootdelegatesfocus", "shadowrootclonable", "shadowrootserializable", "shadowrootcustomelementregistry"},
+	"textarea":        {"autocomplete", "cols", "dirname", "disabled", "form", "maxlength", "minlength", "name", "placeholder", "readonly", "required", "rows", "wrap"},
+	"tfoot":           nil,
+	"th":              {"colspan", "rowspan", "headers", "scope", "abbr"},
+	"thead":           nil,
+	"time":            {"datetime"},
+	"title":           nil,
+	"tr":              nil,
+	"track":           {"default", "kind", "label", "src", "srclang"},
+	"u":               nil,
+	"ul":              nil,
+	"var":             nil,
+	"video":           {"src", "crossorigin", "poster", "preload", "autoplay", "playsinline", "loop", "muted", "controls", "width", "height"},
+	"wbr":             nil,
+}
+
+// specGlobalAttributes are the global attributes, which every element has.
+// Besides those of the HTML standard, it has exportparts and part (CSS
+// Shadow Parts) and virtualkeyboardpolicy (VirtualKeyboard API).
+//
+// https://html.spec.whatwg.org/multipage/dom.html#global-attributes
+var specGlobalAttributes = []string{
+	"accesskey", "autocapitalize", "autocorrect", "autofocus", "class",
+	"contenteditable", "dir", "draggable", "enterkeyhint", "exportparts",
+	"hidden", "id", "inert", "inputmode", "is", "itemid", "itemprop",
+	"itemref", "itemscope", "itemtype", "lang", "nonce", "part", "popover",
+	"slot", "spellcheck", "style", "tabindex", "title", "translate",
+	"virtualkeyboardpolicy", "writingsuggestions",
+}
+
+// specBooleanAttributes are the boolean attributes, whose setters take an
+// optional bool. The setters of the other attributes take a value.
+//
+// https://html.spec.whatwg.org/multipage/indices.html#attributes-3
+var specBooleanAttributes = []string{
+	"allowfullscreen", "alpha", "async", "autofocus", "autoplay", "checked",
+	"controls", "default", "defer", "disabled", "formnovalidate", "hidden",
+	"inert", "ismap", "itemscope", "loop", "multiple", "muted", "nomodule",
+	"novalidate", "open", "playsinline", "readonly", "required", "reversed",
+	"selected", "shadowrootclonable", "shadowrootcustomelementregistry",
+	"shadowrootdelegatesfocus", "shadowrootserializable",
+}
+
+// specMethods are the setters whose name is not the attribute name.
+var specMethods = map[string]string{
+	"object data": "DataURL",
+}
+
+func TestSpecCoverage(t *testing.T) {
+	for tag, attributes := range specElements {
+		ctor, ok := parseConstructors[tag]
+		if !ok {
+			t.Errorf("missing element <%s>", tag)
+			continue
+		}
+
+		typ := reflect.TypeOf(ctor())
+		for _, name := range slices.Concat(attributes, specGlobalAttributes) {
+			method, ok := specMethods[tag+" "+name]
+			if !ok {
+				method = strings.ReplaceAll(name, "-", "")
+			}
+			m, ok := methodByName(typ, method)
+			switch {
+			case !ok:
+				t.Errorf("missing attribute %s of <%s>", name, tag)
+			case slices.Contains(specBooleanAttributes, name):
+				if m.Type.NumIn() != 2 || !m.Type.IsVariadic() || m.Type.In(1).Elem().Kind() != reflect.Bool {
+					t.Errorf("%s of <%s>: got %v, want an optional bool", m.Name, tag, m.Type)
+				}
+			case m.Type.NumIn() < 2:
+				t.Errorf("%s of <%s>: got %v, want a value", m.Name, tag, m.Type)
+			}
+		}
+	}
+}
+
+// methodByName returns the method of t called name, ignoring case.
+func methodByName(t reflect.Type, name string) (reflect.Method, bool) {
+	for i := range t.NumMethod() {
+		if strings.EqualFold(t.Method(i).Name, name) {
+			return t.Method(i), true
+		}
+	}
+	return reflect.Method{}, false
+}
+
+func TestSpecElements(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"audio", Audio(Source().Src("a.ogg")).Controls().Loop().PreLoad(PreLoadNone), `<audio controls loop preload="none"><source src="a.ogg"/></audio>`},
+		{"ruby", Ruby("漢", Rp("("), Rt("kan"), Rp(")")), `<ruby>漢<rp>(</rp><rt>kan</rt><rp>)</rp></ruby>`},
+		{"selectedcontent", Select(Button(SelectedContent()), Option("a")), `<select><button><selectedcontent></selectedcontent></button><option>a</option></select>`},
+		{"th", Th("Population").Abbr("Pop.").Scope(ScopeCol), `<th abbr="Pop." scope="col">Population</th>`},
+		{"fencedframe", FencedFrame().Width(320).Height(50), `<fencedframe width="320" height="50"></fencedframe>`},
+		{"head", Head(Meta().Name("theme-color").Media("(prefers-color-scheme: dark)")).Id("head"), `<head id="head"><meta name="theme-color" media="(prefers-color-scheme: dark)"/></head>`},
+		{"img", Img().Src("a.png").Width(10).Height(20).FetchPriority(FetchPriorityHigh), `<img src="a.png" width="10" height="20" fetchpriority="high"/>`},
+		{"command", Button().CommandFor("menu").Command("--open"), `<button commandfor="menu" command="--open"></button>`},
+		{"template", Template().ShadowRootMode(ShadowRootOpen).ShadowRootClonable(), `<template shadowrootmode="open" shadowrootclonable></template>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGlobalAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"enterkeyhint", Input().EnterKeyHint(EnterKeyHintGo), `<input enterkeyhint="go"/>`},
+		{"popover", Div().Popover(), `<div popover></div>`},
+		{"popover hint", Div().Popover(PopoverHint), `<div popover="hint"></div>`},
+		{"hidden", P().HiddenUntilFound(), `<p hidden="until-found"></p>`},
+		{"microdata", Div().ItemScope().ItemType("https://schema.org/Person").ItemRef("a", "b"), `<div itemscope itemtype="https://schema.org/Person" itemref="a b"></div>`},
+		{"parts", Span().Part("label", "active").ExportParts("icon", "text:button-text"), `<span part="label active" exportparts="icon, text:button-text"></span>`},
+		{"editing", Div().AutoCorrect(false).WritingSuggestions(false).VirtualKeyboardPolicy(VirtualKeyboardPolicyManual), `<div autocorrect="off" writingsuggestions="false" virtualkeyboardpolicy="manual"></div>`},
+		{"script", Script().Nonce("r4nd0m").Is("x-script").Slot("head"), `<script nonce="r4nd0m" is="x-script" slot="head"></script>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -2,9 +2,22 @@ package renderHTML
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
+// AutoEscape controls how plain strings passed as content are rendered.
+//
+// When it is true (the default) every string, number or boolean received by
+// AddContent, or by the element constructors, is escaped according to the
+// context it is rendered in: HTML text, <script> or <style> content. Use
+// RawString to include HTML that must not be escaped.
+//
+// Setting it to false restores the previous behavior, where plain strings are
+// written verbatim as if they were wrapped in RawString. It is meant as a
+// migration switch and must be set before any element is built.
+var AutoEscape = true
+
 var htmlEscaper = strings.NewReplacer(
 	"&", "&amp;",
 	"<", "&lt;",
@@ -13,6 +26,116 @@ var htmlEscaper = strings.NewReplacer(
 	"'", "&#39;",
 )
 
+// #region ESCAPE CONTEXTS
+
+// escapeContext identifies where a piece of text is going to be written.
+type escapeContext int
+
+const (
+	// textContext is the content of a normal element.
+	textContext escapeContext = iota
+
+	// attributeContext is the value of a double quoted attribute.
+	attributeContext
+
+	// scriptContext is the content of a <script> element.
+	scriptContext
+
+	// styleContext is the content of a <style> element.
+	styleContext
+
+	// urlContext is the value of an attribute that holds a URL.
+	urlContext
+)
+
+// safeURLSchemes are the URL schemes allowed in URL attributes. Relative URLs
+// are always allowed.
+var safeURLSchemes = []string{"http", "https", "mailto", "tel", "ftp", "sms"}
+
+// unsafeURL replaces URLs with a scheme that is not allowed, like
+// "javascript:". It is a valid URL that leads nowhere.
+const unsafeURL = "about:invalid#renderHTML"
+
+// escape returns s escaped for the given context.
+func escape(ctx escapeContext, s string) string {
+	switch ctx {
+	case scriptContext:
+		return escapeRawText(s, "script")
+	case styleContext:
+		return escapeRawText(s, "style")
+	case urlContext:
+		return htmlEscaper.Replace(sanitizeURL(s))
+	default:
+		return htmlEscaper.Replace(s)
+	}
+}
+
+// escapeRawText escapes the content of the raw text elements <script> and
+// <style>. Character references are not decoded inside them, so the only
+// thing to prevent is an early end of the element: "</tag" becomes "<\/tag"
+// and "<!--" becomes "<\!--", which are equivalent in both JavaScript strings
+// and CSS.
+func escapeRawText(s, tag string) string {
+	if !strings.Contains(s, "<") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + 8)
+	for i := 0; i < len(s); i++ {
+		b.WriteByte(s[i])
+		if s[i] != '<' {
+			continue
+		}
+
+		rest := s[i+1:]
+		switch {
+		case strings.HasPrefix(rest, "!--"):
+			b.WriteByte('\\')
+		case len(rest) > len(tag) && rest[0] == '/' && strings.EqualFold(rest[1:len(tag)+1], tag):
+			b.WriteByte('\\')
+		}
+	}
+
+	return b.String()
+}
+
+// sanitizeURL returns s unchanged when it is a relative URL or its scheme is
+// one of safeURLSchemes. Otherwise it returns unsafeURL.
+func sanitizeURL(s string) string {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil {
+		// browsers ignore leading control characters and spaces, so a URL
+		// that fails to parse could still be dangerous.
+		return unsafeURL
+	}
+
+	if u.Scheme == "" {
+		return s
+	}
+
+	for _, scheme := range safeURLSchemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return s
+		}
+	}
+
+	return unsafeURL
+}
+
+// #region Text
+
+// textEntity is a plain text that is escaped when rendered.
+type textEntity struct {
+	context escapeContext
+	content string
+}
+
+// String returns HTML text of the current element.
+func (p *textEntity) String() string {
+	return escape(p.context, p.content)
+}
+
 // #region EscapeString
 
 type escapeStringEntity struct {
@@ -49,6 +172,9 @@ func rawString(format string, args ...any) *rawStringEntity {
 
 // RawString creates an element that is used to include WYSIWYG elements.
 // Especially to include HTML formatted text strings.
+//
+// The content is written exactly as received, it is never escaped. Do not use
+// it with text coming from users.
 func RawString(format string, args ...any) *rawStringEntity {
 	return rawString(format, args...)
 }
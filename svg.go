@@ -0,0 +1,1321 @@
+package renderHTML
+
+// #region SVG
+// SVG (Scalable Vector Graphics) elements draw vector images, like icons or
+// charts, directly in the page. The constructors are prefixed with Svg, as
+// some of them, like <title>, have the same name as an HTML element.
+//
+// SVG elements are written with a self-closing tag when they have no content,
+// like <circle r="4"/>, as both SVG documents and HTML allow it. Unlike HTML,
+// SVG attribute names are case-sensitive, so they are written as the methods
+// set them, like viewBox.
+//
+// Example:
+//
+//	Svg(
+//		SvgTitle("Close"),
+//		SvgPath().D("M6 6 18 18M6 18 18 6").Stroke("currentColor").StrokeWidth("2"),
+//	).ViewBox("0 0 24 24").Width("24").Height("24")
+
+// svgNamespace is the XML namespace of the SVG elements.
+const svgNamespace = "http://www.w3.org/2000/svg"
+
+// newForeignElement creates an element of SVG or MathML, which is written
+// with a self-closing tag when it has no content.
+func newForeignElement(tag string, content ...any) *element {
+	ne := newElement(tag, true, content...)
+	ne.foreign = true
+	return ne
+}
+
+// #region presentation attributes
+
+type attrPresentation[T any] struct {
+	attribute[T]
+}
+
+// Fill sets the color, gradient or pattern used to paint the inside of the
+// shape, like "red", "none" or "url(#gradient)".
+func (p *attrPresentation[T]) Fill(value string) *T {
+	p.el.addAttribute("fill", value)
+	return p.t
+}
+
+// FillOpacity sets the opacity of the fill, from 0 to 1.
+func (p *attrPresentation[T]) FillOpacity(value string) *T {
+	p.el.addAttribute("fill-opacity", value)
+	return p.t
+}
+
+// FillRule is a value of the fill-rule and clip-rule attributes.
+type FillRule string
+
+const (
+	FillRuleNonZero FillRule = "nonzero"
+	FillRuleEvenOdd FillRule = "evenodd"
+)
+
+// FillRule sets the algorithm that decides which parts of the shape are
+// inside.
+func (p *attrPresentation[T]) FillRule(value FillRule) *T {
+	addKeyword(p.el, "fill-rule", value, FillRuleNonZero, FillRuleEvenOdd)
+	return p.t
+}
+
+// Stroke sets the color, gradient or pattern used to paint the outline of the
+// shape.
+func (p *attrPresentation[T]) Stroke(value string) *T {
+	p.el.addAttribute("stroke", value)
+	return p.t
+}
+
+// StrokeWidth sets the width of the outline.
+func (p *attrPresentation[T]) StrokeWidth(value string) *T {
+	p.el.addAttribute("stroke-width", value)
+	return p.t
+}
+
+// StrokeOpacity sets the opacity of the outline, from 0 to 1.
+func (p *attrPresentation[T]) StrokeOpacity(value string) *T {
+	p.el.addAttribute("stroke-opacity", value)
+	return p.t
+}
+
+// StrokeLinecap is a value of the stroke-linecap attribute.
+type StrokeLinecap string
+
+const (
+	StrokeLinecapButt   StrokeLinecap = "butt"
+	StrokeLinecapRound  StrokeLinecap = "round"
+	StrokeLinecapSquare StrokeLinecap = "square"
+)
+
+// StrokeLinecap sets the shape of the ends of open lines.
+func (p *attrPresentation[T]) StrokeLinecap(value StrokeLinecap) *T {
+	addKeyword(p.el, "stroke-linecap", value, StrokeLinecapButt, StrokeLinecapRound, StrokeLinecapSquare)
+	return p.t
+}
+
+// StrokeLinejoin is a value of the stroke-linejoin attribute.
+type StrokeLinejoin string
+
+const (
+	StrokeLinejoinArcs      StrokeLinejoin = "arcs"
+	StrokeLinejoinBevel     StrokeLinejoin = "bevel"
+	StrokeLinejoinMiter     StrokeLinejoin = "miter"
+	StrokeLinejoinMiterClip StrokeLinejoin = "miter-clip"
+	StrokeLinejoinRound     StrokeLinejoin = "round"
+)
+
+// StrokeLinejoin sets the shape of the corners of the outline.
+func (p *attrPresentation[T]) StrokeLinejoin(value StrokeLinejoin) *T {
+	addKeyword(p.el, "stroke-linejoin", value, StrokeLinejoinArcs, StrokeLinejoinBevel, StrokeLinejoinMiter, StrokeLinejoinMiterClip, StrokeLinejoinRound)
+	return p.t
+}
+
+// StrokeDasharray sets the pattern of dashes and gaps of the outline, like
+// "4 2".
+func (p *attrPresentation[T]) StrokeDasharray(value string) *T {
+	p.el.addAttribute("stroke-dasharray", value)
+	return p.t
+}
+
+// StrokeDashoffset sets where the dash pattern starts.
+func (p *attrPresentation[T]) StrokeDashoffset(value string) *T {
+	p.el.addAttribute("stroke-dashoffset", value)
+	return p.t
+}
+
+// StrokeMiterlimit sets the limit of the length of miter joins.
+func (p *attrPresentation[T]) StrokeMiterlimit(value string) *T {
+	p.el.addAttribute("stroke-miterlimit", value)
+	return p.t
+}
+
+// Opacity sets the opacity of the element and its content, from 0 to 1.
+func (p *attrPresentation[T]) Opacity(value string) *T {
+	p.el.addAttribute("opacity", value)
+	return p.t
+}
+
+// Transform sets the transformations applied to the element, like
+// "translate(10 10) rotate(45)".
+func (p *attrPresentation[T]) Transform(value string) *T {
+	p.el.addAttribute("transform", value)
+	return p.t
+}
+
+// TransformOrigin sets the origin of the transformations.
+func (p *attrPresentation[T]) TransformOrigin(value string) *T {
+	p.el.addAttribute("transform-origin", value)
+	return p.t
+}
+
+// ClipPath sets the clipping path of the element, like "url(#clip)".
+func (p *attrPresentation[T]) ClipPath(value string) *T {
+	p.el.addAttribute("clip-path", value)
+	return p.t
+}
+
+// ClipRule sets the algorithm that decides which parts of a clipping path
+// are inside.
+func (p *attrPresentation[T]) ClipRule(value FillRule) *T {
+	addKeyword(p.el, "clip-rule", value, FillRuleNonZero, FillRuleEvenOdd)
+	return p.t
+}
+
+// Mask sets the mask of the element, like "url(#mask)".
+func (p *attrPresentation[T]) Mask(value string) *T {
+	p.el.addAttribute("mask", value)
+	return p.t
+}
+
+// Filter sets the filter effects applied to the element.
+func (p *attrPresentation[T]) Filter(value string) *T {
+	p.el.addAttribute("filter", value)
+	return p.t
+}
+
+// Color sets the value of currentColor for the element and its content.
+func (p *attrPresentation[T]) Color(value string) *T {
+	p.el.addAttribute("color", value)
+	return p.t
+}
+
+// Display sets how the element is rendered; "none" hides it.
+func (p *attrPresentation[T]) Display(value string) *T {
+	p.el.addAttribute("display", value)
+	return p.t
+}
+
+// Visibility sets whether the element is visible.
+func (p *attrPresentation[T]) Visibility(value string) *T {
+	p.el.addAttribute("visibility", value)
+	return p.t
+}
+
+// FontFamily sets the font of the text.
+func (p *attrPresentation[T]) FontFamily(value string) *T {
+	p.el.addAttribute("font-family", value)
+	return p.t
+}
+
+// FontSize sets the size of the text.
+func (p *attrPresentation[T]) FontSize(value string) *T {
+	p.el.addAttribute("font-size", value)
+	return p.t
+}
+
+// FontWeight sets the weight of the text, like "bold" or "600".
+func (p *attrPresentation[T]) FontWeight(value string) *T {
+	p.el.addAttribute("font-weight", value)
+	return p.t
+}
+
+// TextAnchor is a value of the text-anchor attribute.
+type TextAnchor string
+
+const (
+	TextAnchorStart  TextAnchor = "start"
+	TextAnchorMiddle TextAnchor = "middle"
+	TextAnchorEnd    TextAnchor = "end"
+)
+
+// TextAnchor aligns the text relative to its position.
+func (p *attrPresentation[T]) TextAnchor(value TextAnchor) *T {
+	addKeyword(p.el, "text-anchor", value, TextAnchorStart, TextAnchorMiddle, TextAnchorEnd)
+	return p.t
+}
+
+// DominantBaseline sets the baseline used to align the text vertically.
+func (p *attrPresentation[T]) DominantBaseline(value string) *T {
+	p.el.addAttribute("dominant-baseline", value)
+	return p.t
+}
+
+// StopColor sets the color of a gradient stop.
+func (p *attrPresentation[T]) StopColor(value string) *T {
+	p.el.addAttribute("stop-color", value)
+	return p.t
+}
+
+// StopOpacity sets the opacity of a gradient stop, from 0 to 1.
+func (p *attrPresentation[T]) StopOpacity(value string) *T {
+	p.el.addAttribute("stop-opacity", value)
+	return p.t
+}
+
+// VectorEffect changes how the element is drawn, like "non-scaling-stroke".
+func (p *attrPresentation[T]) VectorEffect(value string) *T {
+	p.el.addAttribute("vector-effect", value)
+	return p.t
+}
+
+// PaintOrder sets the order in which the fill, stroke and markers are
+// painted.
+func (p *attrPresentation[T]) PaintOrder(value string) *T {
+	p.el.addAttribute("paint-order", value)
+	return p.t
+}
+
+// ShapeRendering hints the trade-offs of drawing shapes, like
+// "crispEdges".
+func (p *attrPresentation[T]) ShapeRendering(value string) *T {
+	p.el.addAttribute("shape-rendering", value)
+	return p.t
+}
+
+// PointerEvents sets whether the element can be the target of pointer
+// events.
+func (p *attrPresentation[T]) PointerEvents(value string) *T {
+	p.el.addAttribute("pointer-events", value)
+	return p.t
+}
+
+// #region position
+// <svg>, <rect>, <text>, <tspan>, <use>, <symbol>, <mask>
+
+type attrSvgPosition[T any] struct {
+	attribute[T]
+}
+
+// X sets the x coordinate of the element.
+func (p *attrSvgPosition[T]) X(value string) *T {
+	p.el.addAttribute("x", value)
+	return p.t
+}
+
+// Y sets the y coordinate of the element.
+func (p *attrSvgPosition[T]) Y(value string) *T {
+	p.el.addAttribute("y", value)
+	return p.t
+}
+
+// #region size
+// <svg>, <rect>, <use>, <symbol>, <mask>
+
+type attrSvgSize[T any] struct {
+	attribute[T]
+}
+
+// Width sets the width of the element.
+func (p *attrSvgSize[T]) Width(value string) *T {
+	p.el.addAttribute("width", value)
+	return p.t
+}
+
+// Height sets the height of the element.
+func (p *attrSvgSize[T]) Height(value string) *T {
+	p.el.addAttribute("height", value)
+	return p.t
+}
+
+// #region viewBox
+// <svg>, <symbol>
+
+type attrViewBox[T any] struct {
+	attribute[T]
+}
+
+// ViewBox sets the position and size of the viewport in user space, like
+// "0 0 24 24" (min-x, min-y, width and height).
+func (p *attrViewBox[T]) ViewBox(value string) *T {
+	p.el.addAttribute("viewBox", value)
+	return p.t
+}
+
+// PreserveAspectRatio sets how the content is scaled when the viewport has
+// a different aspect ratio, like "xMidYMid meet" (default) or "none".
+func (p *attrViewBox[T]) PreserveAspectRatio(value string) *T {
+	p.el.addAttribute("preserveAspectRatio", value)
+	return p.t
+}
+
+// #region pathLength
+// <path>, <circle>, <rect>, <line>, <polyline>, <polygon>
+
+type attrPathLength[T any] struct {
+	attribute[T]
+}
+
+// PathLength sets the total length of the path, in user units, used to
+// scale the distances like stroke-dasharray.
+func (p *attrPathLength[T]) PathLength(value string) *T {
+	p.el.addAttribute("pathLength", value)
+	return p.t
+}
+
+// #region endpoints
+// <line>, <linearGradient>
+
+type attrSvgEndpoints[T any] struct {
+	attribute[T]
+}
+
+// X1 sets the x coordinate of the start.
+func (p *attrSvgEndpoints[T]) X1(value string) *T {
+	p.el.addAttribute("x1", value)
+	return p.t
+}
+
+// Y1 sets the y coordinate of the start.
+func (p *attrSvgEndpoints[T]) Y1(value string) *T {
+	p.el.addAttribute("y1", value)
+	return p.t
+}
+
+// X2 sets the x coordinate of the end.
+func (p *attrSvgEndpoints[T]) X2(value string) *T {
+	p.el.addAttribute("x2", value)
+	return p.t
+}
+
+// Y2 sets the y coordinate of the end.
+func (p *attrSvgEndpoints[T]) Y2(value string) *T {
+	p.el.addAttribute("y2", value)
+	return p.t
+}
+
+// #region points
+// <polyline>, <polygon>
+
+type attrPoints[T any] struct {
+	attribute[T]
+}
+
+// Points sets the list of points of the shape, like "0,0 10,0 10,10".
+func (p *attrPoints[T]) Points(value string) *T {
+	p.el.addAttribute("points", value)
+	return p.t
+}
+
+// #region text positioning
+// <text>, <tspan>
+
+type attrSvgText[T any] struct {
+	attribute[T]
+}
+
+// Dx shifts the text horizontally.
+func (p *attrSvgText[T]) Dx(value string) *T {
+	p.el.addAttribute("dx", value)
+	return p.t
+}
+
+// Dy shifts the text vertically.
+func (p *attrSvgText[T]) Dy(value string) *T {
+	p.el.addAttribute("dy", value)
+	return p.t
+}
+
+// Rotate sets the rotation of each character, in degrees.
+func (p *attrSvgText[T]) Rotate(value string) *T {
+	p.el.addAttribute("rotate", value)
+	return p.t
+}
+
+// TextLength sets the width the text is adjusted to.
+func (p *attrSvgText[T]) TextLength(value string) *T {
+	p.el.addAttribute("textLength", value)
+	return p.t
+}
+
+// LengthAdjust is a value of the lengthAdjust attribute.
+type LengthAdjust string
+
+const (
+	LengthAdjustSpacing          LengthAdjust = "spacing"
+	LengthAdjustSpacingAndGlyphs LengthAdjust = "spacingAndGlyphs"
+)
+
+// LengthAdjust sets how the text is adjusted to its textLength.
+func (p *attrSvgText[T]) LengthAdjust(value LengthAdjust) *T {
+	addKeyword(p.el, "lengthAdjust", value, LengthAdjustSpacing, LengthAdjustSpacingAndGlyphs)
+	return p.t
+}
+
+// #region <svg>
+
+// SvgElement represents the <svg> element.
+type SvgElement struct {
+	*element
+	*attrViewBox[SvgElement]
+	*attrSvgPosition[SvgElement]
+	*attrSvgSize[SvgElement]
+	*attrPresentation[SvgElement]
+
+	*attrGlobal[SvgElement]
+	*attrExternalAttributes[SvgElement]
+	*attrOn[SvgElement]
+	*addContentFunc[SvgElement]
+}
+
+// Viewbox is the SVG viewport coordinates for the current SVG fragment.
+//
+// Deprecated: use ViewBox. Viewbox wrote the attribute as "viewbox", which
+// is ignored by SVG documents, as their attribute names are case-sensitive.
+func (p *SvgElement) Viewbox(value string) *SvgElement {
+	return p.ViewBox(value)
+}
+
+// Svg is an container defining a new coordinate system and viewport. It is
+// used as the outermost element of SVG documents, but it can also be used to
+// embed an SVG fragment inside an SVG or HTML document.
+//
+// It is written with the SVG namespace, xmlns="http://www.w3.org/2000/svg",
+// which HTML doesn't need but SVG files and images do, so the same element
+// can be served as an image.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/svg
+func Svg(content ...any) *SvgElement {
+	ne := newForeignElement("svg", content...)
+	ne.addAttribute("xmlns", svgNamespace)
+
+	var a = new(attrViewBox[SvgElement])
+	var b = new(attrSvgPosition[SvgElement])
+	var c = new(attrSvgSize[SvgElement])
+	var d = new(attrPresentation[SvgElement])
+	var ga = new(attrGlobal[SvgElement])
+	var ea = new(attrExternalAttributes[SvgElement])
+	var on = new(attrOn[SvgElement])
+	var ac = new(addContentFunc[SvgElement])
+	var el = &SvgElement{ne, a, b, c, d, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	d.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <g>
+
+// SvgGElement represents the SVG <g> element.
+type SvgGElement struct {
+	*element
+	*attrPresentation[SvgGElement]
+
+	*attrGlobal[SvgGElement]
+	*attrExternalAttributes[SvgGElement]
+	*attrOn[SvgGElement]
+	*addContentFunc[SvgGElement]
+}
+
+// SvgG groups SVG elements. Its presentation attributes and transform apply to
+// all of its children.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/g
+func SvgG(content ...any) *SvgGElement {
+	ne := newForeignElement("g", content...)
+
+	var a = new(attrPresentation[SvgGElement])
+	var ga = new(attrGlobal[SvgGElement])
+	var ea = new(attrExternalAttributes[SvgGElement])
+	var on = new(attrOn[SvgGElement])
+	var ac = new(addContentFunc[SvgGElement])
+	var el = &SvgGElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <path>
+
+// SvgPathElement represents the SVG <path> element.
+type SvgPathElement struct {
+	*element
+	*attrPresentation[SvgPathElement]
+	*attrPathLength[SvgPathElement]
+
+	*attrGlobal[SvgPathElement]
+	*attrExternalAttributes[SvgPathElement]
+	*attrOn[SvgPathElement]
+	*addContentFunc[SvgPathElement]
+}
+
+// D sets the commands of the path, like "M 10 10 H 90 V 90 Z".
+func (p *SvgPathElement) D(value string) *SvgPathElement {
+	p.addAttribute("d", value)
+	return p
+}
+
+// SvgPath draws a shape defined by a path, the most flexible of the basic
+// shapes.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/path
+func SvgPath(content ...any) *SvgPathElement {
+	ne := newForeignElement("path", content...)
+
+	var a = new(attrPresentation[SvgPathElement])
+	var b = new(attrPathLength[SvgPathElement])
+	var ga = new(attrGlobal[SvgPathElement])
+	var ea = new(attrExternalAttributes[SvgPathElement])
+	var on = new(attrOn[SvgPathElement])
+	var ac = new(addContentFunc[SvgPathElement])
+	var el = &SvgPathElement{ne, a, b, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <circle>
+
+// SvgCircleElement represents the SVG <circle> element.
+type SvgCircleElement struct {
+	*element
+	*attrPresentation[SvgCircleElement]
+	*attrPathLength[SvgCircleElement]
+
+	*attrGlobal[SvgCircleElement]
+	*attrExternalAttributes[SvgCircleElement]
+	*attrOn[SvgCircleElement]
+	*addContentFunc[SvgCircleElement]
+}
+
+// Cx sets the x coordinate of the center.
+func (p *SvgCircleElement) Cx(value string) *SvgCircleElement {
+	p.addAttribute("cx", value)
+	return p
+}
+
+// Cy sets the y coordinate of the center.
+func (p *SvgCircleElement) Cy(value string) *SvgCircleElement {
+	p.addAttribute("cy", value)
+	return p
+}
+
+// R sets the radius.
+func (p *SvgCircleElement) R(value string) *SvgCircleElement {
+	p.addAttribute("r", value)
+	return p
+}
+
+// SvgCircle draws a circle from its center and radius.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/circle
+func SvgCircle(content ...any) *SvgCircleElement {
+	ne := newForeignElement("circle", content...)
+
+	var a = new(attrPresentation[SvgCircleElement])
+	var b = new(attrPathLength[SvgCircleElement])
+	var ga = new(attrGlobal[SvgCircleElement])
+	var ea = new(attrExternalAttributes[SvgCircleElement])
+	var on = new(attrOn[SvgCircleElement])
+	var ac = new(addContentFunc[SvgCircleElement])
+	var el = &SvgCircleElement{ne, a, b, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <rect>
+
+// SvgRectElement represents the SVG <rect> element.
+type SvgRectElement struct {
+	*element
+	*attrPresentation[SvgRectElement]
+	*attrPathLength[SvgRectElement]
+	*attrSvgPosition[SvgRectElement]
+	*attrSvgSize[SvgRectElement]
+
+	*attrGlobal[SvgRectElement]
+	*attrExternalAttributes[SvgRectElement]
+	*attrOn[SvgRectElement]
+	*addContentFunc[SvgRectElement]
+}
+
+// Rx sets the horizontal radius of the corners.
+func (p *SvgRectElement) Rx(value string) *SvgRectElement {
+	p.addAttribute("rx", value)
+	return p
+}
+
+// Ry sets the vertical radius of the corners.
+func (p *SvgRectElement) Ry(value string) *SvgRectElement {
+	p.addAttribute("ry", value)
+	return p
+}
+
+// SvgRect draws a rectangle, optionally with rounded corners.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/rect
+func SvgRect(content ...any) *SvgRectElement {
+	ne := newForeignElement("rect", content...)
+
+	var a = new(attrPresentation[SvgRectElement])
+	var b = new(attrPathLength[SvgRectElement])
+	var c = new(attrSvgPosition[SvgRectElement])
+	var d = new(attrSvgSize[SvgRectElement])
+	var ga = new(attrGlobal[SvgRectElement])
+	var ea = new(attrExternalAttributes[SvgRectElement])
+	var on = new(attrOn[SvgRectElement])
+	var ac = new(addContentFunc[SvgRectElement])
+	var el = &SvgRectElement{ne, a, b, c, d, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	d.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <line>
+
+// SvgLineElement represents the SVG <line> element.
+type SvgLineElement struct {
+	*element
+	*attrPresentation[SvgLineElement]
+	*attrPathLength[SvgLineElement]
+	*attrSvgEndpoints[SvgLineElement]
+
+	*attrGlobal[SvgLineElement]
+	*attrExternalAttributes[SvgLineElement]
+	*attrOn[SvgLineElement]
+	*addContentFunc[SvgLineElement]
+}
+
+// SvgLine draws a straight line between two points.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/line
+func SvgLine(content ...any) *SvgLineElement {
+	ne := newForeignElement("line", content...)
+
+	var a = new(attrPresentation[SvgLineElement])
+	var b = new(attrPathLength[SvgLineElement])
+	var c = new(attrSvgEndpoints[SvgLineElement])
+	var ga = new(attrGlobal[SvgLineElement])
+	var ea = new(attrExternalAttributes[SvgLineElement])
+	var on = new(attrOn[SvgLineElement])
+	var ac = new(addContentFunc[SvgLineElement])
+	var el = &SvgLineElement{ne, a, b, c, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <polyline>
+
+// SvgPolylineElement represents the SVG <polyline> element.
+type SvgPolylineElement struct {
+	*element
+	*attrPresentation[SvgPolylineElement]
+	*attrPathLength[SvgPolylineElement]
+	*attrPoints[SvgPolylineElement]
+
+	*attrGlobal[SvgPolylineElement]
+	*attrExternalAttributes[SvgPolylineElement]
+	*attrOn[SvgPolylineElement]
+	*addContentFunc[SvgPolylineElement]
+}
+
+// SvgPolyline draws connected straight lines; the last point is not connected to
+// the first.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polyline
+func SvgPolyline(content ...any) *SvgPolylineElement {
+	ne := newForeignElement("polyline", content...)
+
+	var a = new(attrPresentation[SvgPolylineElement])
+	var b = new(attrPathLength[SvgPolylineElement])
+	var c = new(attrPoints[SvgPolylineElement])
+	var ga = new(attrGlobal[SvgPolylineElement])
+	var ea = new(attrExternalAttributes[SvgPolylineElement])
+	var on = new(attrOn[SvgPolylineElement])
+	var ac = new(addContentFunc[SvgPolylineElement])
+	var el = &SvgPolylineElement{ne, a, b, c, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <polygon>
+
+// SvgPolygonElement represents the SVG <polygon> element.
+type SvgPolygonElement struct {
+	*element
+	*attrPresentation[SvgPolygonElement]
+	*attrPathLength[SvgPolygonElement]
+	*attrPoints[SvgPolygonElement]
+
+	*attrGlobal[SvgPolygonElement]
+	*attrExternalAttributes[SvgPolygonElement]
+	*attrOn[SvgPolygonElement]
+	*addContentFunc[SvgPolygonElement]
+}
+
+// SvgPolygon draws a closed shape of connected straight lines.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polygon
+func SvgPolygon(content ...any) *SvgPolygonElement {
+	ne := newForeignElement("polygon", content...)
+
+	var a = new(attrPresentation[SvgPolygonElement])
+	var b = new(attrPathLength[SvgPolygonElement])
+	var c = new(attrPoints[SvgPolygonElement])
+	var ga = new(attrGlobal[SvgPolygonElement])
+	var ea = new(attrExternalAttributes[SvgPolygonElement])
+	var on = new(attrOn[SvgPolygonElement])
+	var ac = new(addContentFunc[SvgPolygonElement])
+	var el = &SvgPolygonElement{ne, a, b, c, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <text>
+
+// SvgTextElement represents the SVG <text> element.
+type SvgTextElement struct {
+	*element
+	*attrPresentation[SvgTextElement]
+	*attrSvgPosition[SvgTextElement]
+	*attrSvgText[SvgTextElement]
+
+	*attrGlobal[SvgTextElement]
+	*attrExternalAttributes[SvgTextElement]
+	*attrOn[SvgTextElement]
+	*addContentFunc[SvgTextElement]
+}
+
+// SvgText draws text.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/text
+func SvgText(content ...any) *SvgTextElement {
+	ne := newForeignElement("text", content...)
+
+	var a = new(attrPresentation[SvgTextElement])
+	var b = new(attrSvgPosition[SvgTextElement])
+	var c = new(attrSvgText[SvgTextElement])
+	var ga = new(attrGlobal[SvgTextElement])
+	var ea = new(attrExternalAttributes[SvgTextElement])
+	var on = new(attrOn[SvgTextElement])
+	var ac = new(addContentFunc[SvgTextElement])
+	var el = &SvgTextElement{ne, a, b, c, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <tspan>
+
+// SvgTSpanElement represents the SVG <tspan> element.
+type SvgTSpanElement struct {
+	*element
+	*attrPresentation[SvgTSpanElement]
+	*attrSvgPosition[SvgTSpanElement]
+	*attrSvgText[SvgTSpanElement]
+
+	*attrGlobal[SvgTSpanElement]
+	*attrExternalAttributes[SvgTSpanElement]
+	*attrOn[SvgTSpanElement]
+	*addContentFunc[SvgTSpanElement]
+}
+
+// SvgTSpan is a part of a text with its own position or style.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/tspan
+func SvgTSpan(content ...any) *SvgTSpanElement {
+	ne := newForeignElement("tspan", content...)
+
+	var a = new(attrPresentation[SvgTSpanElement])
+	var b = new(attrSvgPosition[SvgTSpanElement])
+	var c = new(attrSvgText[SvgTSpanElement])
+	var ga = new(attrGlobal[SvgTSpanElement])
+	var ea = new(attrExternalAttributes[SvgTSpanElement])
+	var on = new(attrOn[SvgTSpanElement])
+	var ac = new(addContentFunc[SvgTSpanElement])
+	var el = &SvgTSpanElement{ne, a, b, c, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <defs>
+
+// SvgDefsElement represents the SVG <defs> element.
+type SvgDefsElement struct {
+	*element
+	*attrGlobal[SvgDefsElement]
+	*attrExternalAttributes[SvgDefsElement]
+	*attrOn[SvgDefsElement]
+	*addContentFunc[SvgDefsElement]
+}
+
+// SvgDefs stores elements that are not drawn directly, like gradients, but
+// referenced by other elements.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/defs
+func SvgDefs(content ...any) *SvgDefsElement {
+	ne := newForeignElement("defs", content...)
+
+	var ga = new(attrGlobal[SvgDefsElement])
+	var ea = new(attrExternalAttributes[SvgDefsElement])
+	var on = new(attrOn[SvgDefsElement])
+	var ac = new(addContentFunc[SvgDefsElement])
+	var el = &SvgDefsElement{ne, ga, ea, on, ac}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <use>
+
+// SvgUseElement represents the SVG <use> element.
+type SvgUseElement struct {
+	*element
+	*attrPresentation[SvgUseElement]
+	*attrHref[SvgUseElement]
+	*attrSvgPosition[SvgUseElement]
+	*attrSvgSize[SvgUseElement]
+
+	*attrGlobal[SvgUseElement]
+	*attrExternalAttributes[SvgUseElement]
+	*attrOn[SvgUseElement]
+	*addContentFunc[SvgUseElement]
+}
+
+// SvgUse draws a copy of the element referenced by its href, like a <symbol>.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/use
+func SvgUse(content ...any) *SvgUseElement {
+	ne := newForeignElement("use", content...)
+
+	var a = new(attrPresentation[SvgUseElement])
+	var b = new(attrHref[SvgUseElement])
+	var c = new(attrSvgPosition[SvgUseElement])
+	var d = new(attrSvgSize[SvgUseElement])
+	var ga = new(attrGlobal[SvgUseElement])
+	var ea = new(attrExternalAttributes[SvgUseElement])
+	var on = new(attrOn[SvgUseElement])
+	var ac = new(addContentFunc[SvgUseElement])
+	var el = &SvgUseElement{ne, a, b, c, d, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	d.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <symbol>
+
+// SvgSymbolElement represents the SVG <symbol> element.
+type SvgSymbolElement struct {
+	*element
+	*attrPresentation[SvgSymbolElement]
+	*attrViewBox[SvgSymbolElement]
+	*attrSvgPosition[SvgSymbolElement]
+	*attrSvgSize[SvgSymbolElement]
+
+	*attrGlobal[SvgSymbolElement]
+	*attrExternalAttributes[SvgSymbolElement]
+	*attrOn[SvgSymbolElement]
+	*addContentFunc[SvgSymbolElement]
+}
+
+// RefX sets the x coordinate of the reference point of the symbol.
+func (p *SvgSymbolElement) RefX(value string) *SvgSymbolElement {
+	p.addAttribute("refX", value)
+	return p
+}
+
+// RefY sets the y coordinate of the reference point of the symbol.
+func (p *SvgSymbolElement) RefY(value string) *SvgSymbolElement {
+	p.addAttribute("refY", value)
+	return p
+}
+
+// SvgSymbol defines a graphic, with its own viewBox, that is drawn by <use>
+// elements.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/symbol
+func SvgSymbol(content ...any) *SvgSymbolElement {
+	ne := newForeignElement("symbol", content...)
+
+	var a = new(attrPresentation[SvgSymbolElement])
+	var b = new(attrViewBox[SvgSymbolElement])
+	var c = new(attrSvgPosition[SvgSymbolElement])
+	var d = new(attrSvgSize[SvgSymbolElement])
+	var ga = new(attrGlobal[SvgSymbolElement])
+	var ea = new(attrExternalAttributes[SvgSymbolElement])
+	var on = new(attrOn[SvgSymbolElement])
+	var ac = new(addContentFunc[SvgSymbolElement])
+	var el = &SvgSymbolElement{ne, a, b, c, d, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	d.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <linearGradient>
+
+// SvgLinearGradientElement represents the SVG <linearGradient> element.
+type SvgLinearGradientElement struct {
+	*element
+	*attrSvgEndpoints[SvgLinearGradientElement]
+	*attrHref[SvgLinearGradientElement]
+
+	*attrGlobal[SvgLinearGradientElement]
+	*attrExternalAttributes[SvgLinearGradientElement]
+	*attrOn[SvgLinearGradientElement]
+	*addContentFunc[SvgLinearGradientElement]
+}
+
+// SvgUnits is the coordinate system of the attributes of gradients, clipping
+// paths and masks.
+type SvgUnits string
+
+const (
+	SvgUnitsUserSpaceOnUse    SvgUnits = "userSpaceOnUse"
+	SvgUnitsObjectBoundingBox SvgUnits = "objectBoundingBox"
+)
+
+// GradientUnits sets the coordinate system of x1, y1, x2 and y2.
+func (p *SvgLinearGradientElement) GradientUnits(value SvgUnits) *SvgLinearGradientElement {
+	addKeyword(p.element, "gradientUnits", value, SvgUnitsUserSpaceOnUse, SvgUnitsObjectBoundingBox)
+	return p
+}
+
+// GradientTransform sets the transformations applied to the gradient.
+func (p *SvgLinearGradientElement) GradientTransform(value string) *SvgLinearGradientElement {
+	p.addAttribute("gradientTransform", value)
+	return p
+}
+
+// SpreadMethod is a value of the spreadMethod attribute.
+type SpreadMethod string
+
+const (
+	SpreadMethodPad     SpreadMethod = "pad"
+	SpreadMethodReflect SpreadMethod = "reflect"
+	SpreadMethodRepeat  SpreadMethod = "repeat"
+)
+
+// SpreadMethod sets how the gradient is painted outside of its bounds.
+func (p *SvgLinearGradientElement) SpreadMethod(value SpreadMethod) *SvgLinearGradientElement {
+	addKeyword(p.element, "spreadMethod", value, SpreadMethodPad, SpreadMethodReflect, SpreadMethodRepeat)
+	return p
+}
+
+// SvgLinearGradient defines a linear gradient, used by the fill or stroke of other
+// elements with "url(#id)".
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/linearGradient
+func SvgLinearGradient(content ...any) *SvgLinearGradientElement {
+	ne := newForeignElement("linearGradient", content...)
+
+	var a = new(attrSvgEndpoints[SvgLinearGradientElement])
+	var b = new(attrHref[SvgLinearGradientElement])
+	var ga = new(attrGlobal[SvgLinearGradientElement])
+	var ea = new(attrExternalAttributes[SvgLinearGradientElement])
+	var on = new(attrOn[SvgLinearGradientElement])
+	var ac = new(addContentFunc[SvgLinearGradientElement])
+	var el = &SvgLinearGradientElement{ne, a, b, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <stop>
+
+// SvgStopElement represents the SVG <stop> element.
+type SvgStopElement struct {
+	*element
+	*attrPresentation[SvgStopElement]
+
+	*attrGlobal[SvgStopElement]
+	*attrExternalAttributes[SvgStopElement]
+	*attrOn[SvgStopElement]
+	*addContentFunc[SvgStopElement]
+}
+
+// Offset sets the position of the stop in the gradient, from 0 to 1 or as a
+// percentage.
+func (p *SvgStopElement) Offset(value string) *SvgStopElement {
+	p.addAttribute("offset", value)
+	return p
+}
+
+// SvgStop defines a color and its position in a gradient.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/stop
+func SvgStop(content ...any) *SvgStopElement {
+	ne := newForeignElement("stop", content...)
+
+	var a = new(attrPresentation[SvgStopElement])
+	var ga = new(attrGlobal[SvgStopElement])
+	var ea = new(attrExternalAttributes[SvgStopElement])
+	var on = new(attrOn[SvgStopElement])
+	var ac = new(addContentFunc[SvgStopElement])
+	var el = &SvgStopElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <clipPath>
+
+// SvgClipPathElement represents the SVG <clipPath> element.
+type SvgClipPathElement struct {
+	*element
+	*attrPresentation[SvgClipPathElement]
+
+	*attrGlobal[SvgClipPathElement]
+	*attrExternalAttributes[SvgClipPathElement]
+	*attrOn[SvgClipPathElement]
+	*addContentFunc[SvgClipPathElement]
+}
+
+// ClipPathUnits sets the coordinate system of the content of the clipping
+// path.
+func (p *SvgClipPathElement) ClipPathUnits(value SvgUnits) *SvgClipPathElement {
+	addKeyword(p.element, "clipPathUnits", value, SvgUnitsUserSpaceOnUse, SvgUnitsObjectBoundingBox)
+	return p
+}
+
+// SvgClipPath defines a clipping path, used by the clip-path attribute of other
+// elements.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/clipPath
+func SvgClipPath(content ...any) *SvgClipPathElement {
+	ne := newForeignElement("clipPath", content...)
+
+	var a = new(attrPresentation[SvgClipPathElement])
+	var ga = new(attrGlobal[SvgClipPathElement])
+	var ea = new(attrExternalAttributes[SvgClipPathElement])
+	var on = new(attrOn[SvgClipPathElement])
+	var ac = new(addContentFunc[SvgClipPathElement])
+	var el = &SvgClipPathElement{ne, a, ga, ea, on, ac}
+	a.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <mask>
+
+// SvgMaskElement represents the SVG <mask> element.
+type SvgMaskElement struct {
+	*element
+	*attrPresentation[SvgMaskElement]
+	*attrSvgPosition[SvgMaskElement]
+	*attrSvgSize[SvgMaskElement]
+
+	*attrGlobal[SvgMaskElement]
+	*attrExternalAttributes[SvgMaskElement]
+	*attrOn[SvgMaskElement]
+	*addContentFunc[SvgMaskElement]
+}
+
+// MaskUnits sets the coordinate system of x, y, width and height.
+func (p *SvgMaskElement) MaskUnits(value SvgUnits) *SvgMaskElement {
+	addKeyword(p.element, "maskUnits", value, SvgUnitsUserSpaceOnUse, SvgUnitsObjectBoundingBox)
+	return p
+}
+
+// MaskContentUnits sets the coordinate system of the content of the mask.
+func (p *SvgMaskElement) MaskContentUnits(value SvgUnits) *SvgMaskElement {
+	addKeyword(p.element, "maskContentUnits", value, SvgUnitsUserSpaceOnUse, SvgUnitsObjectBoundingBox)
+	return p
+}
+
+// SvgMask defines a mask, used by the mask attribute of other elements.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/mask
+func SvgMask(content ...any) *SvgMaskElement {
+	ne := newForeignElement("mask", content...)
+
+	var a = new(attrPresentation[SvgMaskElement])
+	var b = new(attrSvgPosition[SvgMaskElement])
+	var c = new(attrSvgSize[SvgMaskElement])
+	var ga = new(attrGlobal[SvgMaskElement])
+	var ea = new(attrExternalAttributes[SvgMaskElement])
+	var on = new(attrOn[SvgMaskElement])
+	var ac = new(addContentFunc[SvgMaskElement])
+	var el = &SvgMaskElement{ne, a, b, c, ga, ea, on, ac}
+	a.set(ne, el)
+	b.set(ne, el)
+	c.set(ne, el)
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <title>
+
+// SvgTitleElement represents the SVG <title> element.
+type SvgTitleElement struct {
+	*element
+	*attrGlobal[SvgTitleElement]
+	*attrExternalAttributes[SvgTitleElement]
+	*attrOn[SvgTitleElement]
+	*addContentFunc[SvgTitleElement]
+}
+
+// SvgTitle is the accessible name of its parent element, shown as a tooltip by
+// most browsers.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/title
+func SvgTitle(content ...any) *SvgTitleElement {
+	ne := newForeignElement("title", content...)
+
+	var ga = new(attrGlobal[SvgTitleElement])
+	var ea = new(attrExternalAttributes[SvgTitleElement])
+	var on = new(attrOn[SvgTitleElement])
+	var ac = new(addContentFunc[SvgTitleElement])
+	var el = &SvgTitleElement{ne, ga, ea, on, ac}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
+
+// #region <desc>
+
+// SvgDescElement represents the SVG <desc> element.
+type SvgDescElement struct {
+	*element
+	*attrGlobal[SvgDescElement]
+	*attrExternalAttributes[SvgDescElement]
+	*attrOn[SvgDescElement]
+	*addContentFunc[SvgDescElement]
+}
+
+// SvgDesc is the accessible description of its parent element.
+//
+// For more details, see the [documentation]
+//
+// [documentation]: https://developer.mozilla.org/en-US/docs/Web/SVG/Element/desc
+func SvgDesc(content ...any) *SvgDescElement {
+	ne := newForeignElement("desc", content...)
+
+	var ga = new(attrGlobal[SvgDescElement])
+	var ea = new(attrExternalAttributes[SvgDescElement])
+	var on = new(attrOn[SvgDescElement])
+	var ac = new(addContentFunc[SvgDescElement])
+	var el = &SvgDescElement{ne, ga, ea, on, ac}
+	ga.set(ne, el)
+	ea.set(ne, el)
+	on.set(ne, el)
+	ac.set(ne, el)
+
+	return el
+}
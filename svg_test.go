@@ -0,0 +1,60 @@
+package renderHTML
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSvg(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"empty", Svg(), `<svg xmlns="http://www.w3.org/2000/svg"/>`},
+		{"icon", Svg(
+			SvgTitle("Close"),
+			SvgPath().D("M6 6 18 18M6 18 18 6").Stroke("currentColor").StrokeWidth("2").StrokeLinecap(StrokeLinecapRound),
+		).ViewBox("0 0 24 24").Width("24").Height("24"),
+			`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" width="24" height="24"><title>Close</title><path d="M6 6 18 18M6 18 18 6" stroke="currentColor" stroke-width="2" stroke-linecap="round"/></svg>`},
+		{"viewbox", Svg().Viewbox("0 0 8 8").RemoveAttribute("xmlns"), `<svg viewBox="0 0 8 8"/>`},
+		{"shapes", SvgG(
+			SvgCircle().Cx("4").Cy("4").R("4"),
+			SvgRect().X("0").Y("0").Width("8").Height("8").Rx("1"),
+			SvgLine().X1("0").Y1("0").X2("8").Y2("8"),
+			SvgPolygon().Points("0,0 8,0 8,8").FillRule(FillRuleEvenOdd),
+		).Fill("none").Transform("scale(2)"),
+			`<g fill="none" transform="scale(2)"><circle cx="4" cy="4" r="4"/><rect x="0" y="0" width="8" height="8" rx="1"/><line x1="0" y1="0" x2="8" y2="8"/><polygon points="0,0 8,0 8,8" fill-rule="evenodd"/></g>`},
+		{"text", SvgText("a ", SvgTSpan("<b>").Dy("1em")).X("0").TextAnchor(TextAnchorMiddle),
+			`<text x="0" text-anchor="middle">a <tspan dy="1em">&lt;b&gt;</tspan></text>`},
+		{"gradient", SvgDefs(
+			SvgLinearGradient(SvgStop().Offset("0").StopColor("red")).Id("g").GradientUnits(SvgUnitsUserSpaceOnUse),
+			SvgSymbol(SvgDesc("dot")).Id("s").ViewBox("0 0 2 2"),
+		), `<defs><linearGradient id="g" gradientUnits="userSpaceOnUse"><stop offset="0" stop-color="red"/></linearGradient><symbol id="s" viewBox="0 0 2 2"><desc>dot</desc></symbol></defs>`},
+		{"use", SvgUse().Href("#s").ClipPath("url(#c)").Mask("url(#m)"), `<use href="#s" clip-path="url(#c)" mask="url(#m)"/>`},
+		{"javascript href", SvgUse().Href("javascript:alert(1)"), `<use href="about:invalid#renderHTML"/>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseSvg(t *testing.T) {
+	src := `<p><svg VIEWBOX="0 0 2 2"><linearGradient gradientunits="userSpaceOnUse"/><circle r="1"/><feBlend in="x"></feBlend><foreignObject><p>a<p>b</foreignObject><title>t</title></svg>c</p>`
+	want := `<p><svg viewBox="0 0 2 2"><linearGradient gradientUnits="userSpaceOnUse"/><circle r="1"/><feBlend in="x"/><foreignObject><p>a</p><p>b</p></foreignObject><title>t</title></svg>c</p>`
+
+	root := ParseString(src)
+	if got := root.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, ok := root.ByTag("circle")[0].(*SvgCircleElement); !ok {
+		t.Errorf("got %T, want *SvgCircleElement", root.ByTag("circle")[0])
+	}
+	if _, ok := root.ByTag("title")[0].(*SvgTitleElement); !ok {
+		t.Errorf("got %T, want *SvgTitleElement", root.ByTag("title")[0])
+	}
+}
@@ -1,8 +1,12 @@
 package renderHTML
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 )
 
 // go test -benchmem -run=^$ -bench ^BenchmarkStatistics$ github.com/fabianpallares/renderHTML -count=10
@@ -12,6 +16,35 @@ func BenchmarkStatistics(b *testing.B) {
 	}
 }
 
+// go test -benchmem -run=^$ -bench ^BenchmarkStatisticsString$ github.com/hypermediastack/renderHTML
+func BenchmarkStatisticsString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = one().String()
+	}
+}
+
+func BenchmarkStatisticsRender(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		one().(*HtmlElement).Render(io.Discard)
+	}
+}
+
+func BenchmarkStatisticsTableString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = table(100).String()
+	}
+}
+
+func BenchmarkStatisticsTableRender(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		table(100).Render(io.Discard)
+	}
+}
+
 func TestOne(t *testing.T) {
 	fmt.Println(one())
 }
@@ -121,3 +154,473 @@ func one() fmt.Stringer {
 		),
 	)
 }
+
+func TestAutoEscape(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"text", P(`<b>"Tom" & 'Jerry'</b>`), `<p>&lt;b&gt;&#34;Tom&#34; &amp; &#39;Jerry&#39;&lt;/b&gt;</p>`},
+		{"raw", P(RawString("<b>bold</b>")), `<p><b>bold</b></p>`},
+		{"number", Span(1 < 2, 3), `<span>true3</span>`},
+		{"script", Script(`var s = "</script><script>alert(1)";`), `<script>var s = "<\/script><script>alert(1)";</script>`},
+		{"style", Style(`p{color:red}</STYLE><b>`), `<style>p{color:red}<\/STYLE><b></style>`},
+		{"comment in script", Script(`<!-- x`), `<script><\!-- x</script>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAutoEscapeDisabled(t *testing.T) {
+	AutoEscape = false
+	defer func() { AutoEscape = true }()
+
+	if got, want := P("<b>bold</b>").String(), "<p><b>bold</b></p>"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAttributeEscaping(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"title", Div().Title(`"><script>alert(1)</script>`), `<div title="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;"></div>`},
+		{"data", Div().Data("x", `{"a":"b&c"}`), `<div data-x="{&#34;a&#34;:&#34;b&amp;c&#34;}"></div>`},
+		{"invalid data name", Div().Data(`x" onclick="alert(1)`, "v"), `<div></div>`},
+		{"href", A().Href("/path?a=1&b=2"), `<a href="/path?a=1&amp;b=2"></a>`},
+		{"javascript href", A().Href(" javascript:alert(1)"), `<a href="about:invalid#renderHTML"></a>`},
+		{"class", Div().Class(`a"b`), `<div class="a&#34;b"></div>`},
+		{"raw attributes", Button().AddAttributes(`hx-post='/customer' hx-target="#div-one" hidden`), `<button hx-post="/customer" hx-target="#div-one" hidden></button>`},
+		{"escaped raw attribute", Div().AddAttributes(`title="a &amp; b"`), `<div title="a &amp; b"></div>`},
+		{"injection", Div().AddAttributes(`x="1"><script>`), `<div x="1"></div>`},
+		{"unterminated", Div().AddAttributes(`a="1" b="2`), `<div a="1"></div>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	page := one().(*HtmlElement)
+
+	var s strings.Builder
+	n, err := page.WriteTo(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.String(), page.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if n != int64(s.Len()) {
+		t.Errorf("got %v bytes written, want %v", n, s.Len())
+	}
+	if !strings.HasPrefix(s.String(), "<!DOCTYPE html><html>") {
+		t.Errorf("missing doctype: %v", s.String())
+	}
+
+	s.Reset()
+	if err := Container(P("a"), Hr()).Render(&s); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.String(), "<p>a</p><hr/>"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRenderIndent(t *testing.T) {
+	page := Html(
+		Head(Title("Test")),
+		Body(
+			Div(
+				Container(H1("Title <1>"), P("Some ", Em("text"), ".")),
+				Pre(Div("  keep\n  spaces")),
+				Ul(Li(A("a").Href("/a")), Li("b")),
+			).Class("main"),
+		),
+	)
+
+	want := `<!DOCTYPE html>
+<html>
+  <head>
+    <title>Test</title>
+  </head>
+  <body>
+    <div class="main">
+      <h1>Title &lt;1&gt;</h1>
+      <p>Some <em>text</em>.</p>
+      <pre><div>  keep
+  spaces</div></pre>
+      <ul>
+        <li><a href="/a">a</a></li>
+        <li>b</li>
+      </ul>
+    </div>
+  </body>
+</html>`
+	if got := page.StringIndent("  "); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got, want := P(Custom("x-a", "1"), Custom("x-b", "2")).StringIndent("  "), "<p><x-a>1</x-a><x-b>2</x-b></p>"; got != want {
+		t.Errorf("custom: got %v, want %v", got, want)
+	}
+	if got, want := Custom("x-card", Div("a"), Div("b")).StringIndent("  "), "<x-card><div>a</div><div>b</div></x-card>"; got != want {
+		t.Errorf("custom parent: got %v, want %v", got, want)
+	}
+
+	if got, want := Container(P("a"), Hr()).StringIndent("\t"), "<p>a</p>\n<hr/>"; got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func table(rows int) *TableElement {
+	tb := Tbody()
+	for i := 0; i < rows; i++ {
+		tb.AddContent(Tr(Td(i).Class("number"), Td("name <x>"), Td(3.5)))
+	}
+	return Table(Thead(Tr(Th("#"), Th("Name"), Th("Value"))), tb)
+}
+
+func TestHx(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{
+			"post",
+			Button("Save").AddAttributes(Hx().Post("/customer").Target("#div-one").Swap(SwapInnerHTML, SwapTransition(true))),
+			`<button hx-post="/customer" hx-target="#div-one" hx-swap="innerHTML transition:true">Save</button>`,
+		},
+		{
+			"trigger",
+			Input().AddAttributes(Hx().Get("/search").Trigger(
+				TriggerOn("keyup", TriggerChanged(), TriggerDelay(500*time.Millisecond), TriggerFilter("key=='Enter'")),
+				TriggerEvery(2*time.Second),
+			)),
+			`<input hx-get="/search" hx-trigger="keyup[key==&#39;Enter&#39;] changed delay:500ms, every 2s"/>`,
+		},
+		{
+			"short delay",
+			Input().AddAttributes(Hx().Trigger(TriggerOn("input", TriggerDelay(500*time.Microsecond), TriggerThrottle(1500*time.Microsecond)))),
+			`<input hx-trigger="input delay:1ms throttle:2ms"/>`,
+		},
+		{
+			"vals",
+			Div().AddAttributes(Hx().Vals(map[string]any{"id": 7, "q": `a"b`}).Boost(true).PushURL("true")),
+			`<div hx-vals="{&#34;id&#34;:7,&#34;q&#34;:&#34;a\&#34;b&#34;}" hx-boost="true" hx-push-url="true"></div>`,
+		},
+		{
+			"last value wins",
+			Div().AddAttributes(Hx().Get("/a").Get("/b").SwapOOB(SwapOuterHTML, "#x")),
+			`<div hx-get="/b" hx-swap-oob="outerHTML:#x"></div>`,
+		},
+		{
+			"unsafe url",
+			A().AddAttributes(Hx().Get("javascript:alert(1)")),
+			`<a hx-get="about:invalid#renderHTML"></a>`,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// GetAttributes can be parsed back by AddAttributes without escaping twice.
+	hx := Hx().Vals(map[string]string{"a": "b&c"})
+	if got, want := Div().AddAttributes(hx).String(), Div().AddAttributes(hx.GetAttributes()[0]).String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// words is a fmt.Stringer that can't be compared with ==.
+type words []string
+
+func (w words) String() string {
+	return strings.Join(w, " ")
+}
+
+func TestMutation(t *testing.T) {
+	footer := Footer("f")
+	sidebar := Aside("s")
+	list := words{"a", "b"}
+
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"prepend", Ul(Li("b")).PrependContent(Li("a")), `<ul><li>a</li><li>b</li></ul>`},
+		{"insert", Ul(Li("a"), Li("c")).InsertContent(1, Li("b")), `<ul><li>a</li><li>b</li><li>c</li></ul>`},
+		{"insert out of range", P("a").InsertContent(9, "b").InsertContent(-1, "c"), `<p>cab</p>`},
+		{"remove", Body(Nav("n"), sidebar, footer).RemoveContent(footer), `<body><nav>n</nav><aside>s</aside></body>`},
+		{"replace", Body(Nav("n"), sidebar).ReplaceContent(sidebar, Main("m")), `<body><nav>n</nav><main>m</main></body>`},
+		{"not comparable", P(list, footer).RemoveContent(list, footer).ReplaceContent(list, "x"), `<p>a b</p>`},
+		{"remove at", Ul(Li("a"), Li("b")).RemoveContentAt(0).RemoveContentAt(5), `<ul><li>b</li></ul>`},
+		{"replace at", P("a", "b").ReplaceContentAt(1, "<c>"), `<p>a&lt;c&gt;</p>`},
+		{"clear", Div(P("a")).ClearContent().AddContent("b"), `<div>b</div>`},
+		{"set attribute", A("x").Href("/a").Id("i").SetAttribute("href", "/b"), `<a href="/b" id="i">x</a>`},
+		{"set class", Div().Class("a", "b").SetAttribute("class", "c"), `<div class="c"></div>`},
+		{"remove attribute", Input().Type("text").Required().RemoveAttribute("required", "TYPE"), `<input/>`},
+		{"remove class", Div().Class("a b", "c").RemoveClass("b", "c"), `<div class="a"></div>`},
+		{"toggle class", Div().Class("a").ToggleClass("a").ToggleClass("b").ToggleClass("c", false), `<div class="b"></div>`},
+		{"remove style", Div().Style("color: red; margin: 0", "padding: 1px").RemoveStyle("margin", "PADDING"), `<div style="color: red;"></div>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestKeyedAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"last wins", A("x").Href("/a").Id("i").Href("/b"), `<a href="/b" id="i">x</a>`},
+		{"case-insensitive", Div().AddAttributes(`DATA-X="1"`).Data("x", "2"), `<div data-x="2"></div>`},
+		{"classes", Div().Class("a b").Class("b", "c").AddAttributes(`class="a d"`), `<div class="a b c d"></div>`},
+		{"styles", Div().Style("color: red; margin: 0").Style("COLOR: blue").AddAttributes(`style="padding: 1px"`),
+			`<div style="COLOR: blue; margin: 0; padding: 1px;"></div>`},
+		{"style url", Div().Style(`background: url("a;b.png"); color: red`), `<div style="background: url(&#34;a;b.png&#34;); color: red;"></div>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	Strict = true
+	defer func() { Strict = false }()
+
+	page := Body(
+		Div(A("x").Href("/a").Href("/b")).Id("d").SetAttribute("id", "e"),
+		Container(P().Title("a").Title("b")),
+	)
+
+	errs := page.Errors()
+	want := []string{`<a>: duplicate attribute "href"`, `<p>: duplicate attribute "title"`}
+	if fmt.Sprint(errs) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", errs, want)
+	}
+	for _, err := range errs {
+		if !errors.Is(err, ErrDuplicateAttribute) {
+			t.Errorf("%v is not ErrDuplicateAttribute", err)
+		}
+	}
+
+	if errs := Div().Id("a").Errors(); errs != nil {
+		t.Errorf("got %v, want nil", errs)
+	}
+}
+
+func TestBooleanAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"omitted", Input().Disabled().Required(), `<input disabled required/>`},
+		{"true", Input().AutoFocus(true).Checked(true), `<input autofocus checked/>`},
+		{"false", Input().AutoFocus(false).ReadOnly(false), `<input/>`},
+		{"removed", Details().Open().Inert(true).Open(false), `<details inert></details>`},
+		{"video", Video().PlaysInLine(true).DisableRemotePlayback(false).Muted(), `<video playsinline muted></video>`},
+		{"spellcheck", Textarea().SpellCheck().Id("a").SpellCheck(false), `<textarea spellcheck="false" id="a"></textarea>`},
+		{"draggable", Div().Draggable(false), `<div draggable="false"></div>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEnumeratedAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"target", A("a").Target(TargetBlank).Rel(RelNoOpener, RelNoReferrer), `<a target="_blank" rel="noopener noreferrer">a</a>`},
+		{"string", A("a").Target("frame").Rel("nofollow"), `<a target="frame" rel="nofollow">a</a>`},
+		{"img", Img().Loading(LoadingLazy).Decoding(DecodingAsync).CrossOrigin(CrossOriginAnonymous), `<img loading="lazy" decoding="async" crossorigin="anonymous"/>`},
+		{"global", P().Dir(DirRTL).Translate(TranslateNo).Inputmode(InputModeNumeric), `<p dir="rtl" translate="no" inputmode="numeric"></p>`},
+		{"input", Input().Type(InputTypeEmail), `<input type="email"/>`},
+		{"sandbox", Iframe().Sandbox(), `<iframe sandbox=""></iframe>`},
+		{"shape", Area().Shape(ShapeCircle), `<area shape="circle"/>`},
+		{"ol", Ol().Type(OlTypeUpperRoman), `<ol type="I"></ol>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+
+	page := Body(
+		A("a").Target("_blnk").Rel("noopener noreferer"),
+		Form(Button().Type("Submit").FormTarget("results")).Method("POST"),
+		Video().PreLoad("everything").ControlsList(ControlsListNoDownload),
+		Input().Type("e-mail"),
+		Link().Rel("shortcut icon").Href("favicon.ico"),
+	)
+
+	errs := page.Errors()
+	want := []string{
+		`<a>: invalid value "_blnk" for attribute "target"`,
+		`<a>: invalid value "noreferer" for attribute "rel"`,
+		`<video>: invalid value "everything" for attribute "preload"`,
+		`<input>: invalid value "e-mail" for attribute "type"`,
+	}
+	if fmt.Sprint(errs) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", errs, want)
+	}
+	for _, err := range errs {
+		if !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("%v is not ErrInvalidValue", err)
+		}
+	}
+}
+
+func TestStrictRepairs(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"contenteditable", Div().ContentEditable("yes"), `<div contenteditable></div>`},
+		{"plaintext-only", Div().ContentEditable(ContentEditablePlaintextOnly), `<div contenteditable="plaintext-only"></div>`},
+		{"short event", Button().On("x", "f()"), `<button onx="f()"></button>`},
+		{"empty event", Button().On("", "f()"), `<button></button>`},
+		{"style", P().Style("color: red", "bold"), `<p style="color: red; bold;"></p>`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+
+	page := Body(
+		Div().ContentEditable("yes").SetAttribute(" "),
+		P().Data("a b", "1").Style("color: red; bold").On("on", "f()"),
+		Button().AddAttributes(`hx-post="/a" hx-target="#b`),
+	)
+
+	errs := page.Errors()
+	want := []string{
+		`<div>: invalid value "yes" for attribute "contenteditable"`,
+		`<div>: invalid attribute name ""`,
+		`<p>: invalid attribute name "data-a b"`,
+		`<p>: invalid value "bold" for attribute "style"`,
+		`<p>: invalid attribute: missing event name`,
+		`<button>: invalid attribute: unterminated value of attribute "hx-target"`,
+	}
+	if fmt.Sprint(errs) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", errs, want)
+	}
+
+	var s strings.Builder
+	err := page.RenderStrict(&s)
+	if !errors.Is(err, ErrInvalidAttribute) || !errors.Is(err, ErrInvalidValue) || s.Len() > 0 {
+		t.Errorf("got %v, %q, want the errors and no output", err, s.String())
+	}
+
+	s.Reset()
+	if err := Html(Body(P("a"))).RenderStrict(&s); err != nil || s.String() != "<!DOCTYPE html><html><body><p>a</p></body></html>" {
+		t.Errorf("got %v, %q", err, s.String())
+	}
+}
+
+func TestCustom(t *testing.T) {
+	tests := []struct {
+		name string
+		got  fmt.Stringer
+		want string
+	}{
+		{"element", Custom("sl-button", "Save").Id("save").On("click", "save()"), `<sl-button id="save" onclick="save()">Save</sl-button>`},
+		{"void", CustomVoid("x-icon").AddAttributes(`name="check"`), `<x-icon name="check"/>`},
+		{"content", Custom("turbo-frame").AddContent(P("a")), `<turbo-frame><p>a</p></turbo-frame>`},
+		{"not custom", Custom("foo", "a"), `<foo>a</foo>`},
+		{"unsafe", Custom("x-a><script", "a"), `a`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	names := []struct {
+		name string
+		want bool
+	}{
+		{"my-element", true},
+		{"math-α", true},
+		{"x-1.0_b", true},
+		{"foo", false},
+		{"My-Element", false},
+		{"1-a", false},
+		{"-a", false},
+		{"font-face", false},
+		{"x-a b", false},
+	}
+	for _, tt := range names {
+		if got := ValidCustomElementName(tt.name); got != tt.want {
+			t.Errorf("%v: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+
+	errs := Div(Custom("x-a"), Custom("Foo")).Errors()
+	want := []string{`<Foo>: invalid element name "Foo"`}
+	if fmt.Sprint(errs) != fmt.Sprint(want) || !errors.Is(errs[0], ErrInvalidElement) {
+		t.Errorf("got %v, want %v", errs, want)
+	}
+}
+
+func TestShadowRoot(t *testing.T) {
+	card := Custom("user-card", P("Mathematician")).ShadowRoot(
+		Template(H2(Slot().Name("name")), Slot()),
+	).Slotted("name", "Ada", Em("Lovelace"))
+
+	want := `<user-card><template shadowrootmode="open"><h2><slot name="name"></slot></h2><slot></slot></template>` +
+		`<p>Mathematician</p><span slot="name">Ada</span><em slot="name">Lovelace</em></user-card>`
+	if got := card.String(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	card.ShadowRoot(Template(Slot()).ShadowRootMode(ShadowRootClosed).ShadowRootDelegatesFocus())
+	want = `<user-card><template shadowrootmode="closed" shadowrootdelegatesfocus><slot></slot></template>` +
+		`<p>Mathematician</p><span slot="name">Ada</span><em slot="name">Lovelace</em></user-card>`
+	if got := card.String(); got != want {
+		t.Errorf("replaced: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,537 @@
+package renderHTML
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// #region VALIDATION
+
+// ValidationError is a content model error found by Validate: an element or
+// a text placed where HTML doesn't allow it. Browsers don't reject these
+// documents, they silently move or close the elements, so the resulting page
+// is not the one that was built.
+type ValidationError struct {
+	// Path locates the offending node from the validated element, like
+	// "body > ul > div:nth-child(2)". It is a valid selector for Query.
+	// For texts, it is the path of their parent.
+	Path string `json:"path"`
+
+	// Tag is the tag of the offending element. It is empty for texts.
+	Tag string `json:"tag"`
+
+	// Message describes the error.
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Validate checks that the children of every element, and all their
+// descendants, are allowed by the content model of the HTML standard. For
+// example, it reports a <li> inside a <div>, a <div> inside a <p>, a <form>
+// inside another <form> or a <button> inside an <a>.
+//
+// It returns nil when the tree is valid. The content of RawString and of any
+// fmt.Stringer that is not an element of the package is not checked.
+func (p *element) Validate() []ValidationError {
+	v := &validator{}
+	if p.tag == "" {
+		v.validateContent(p, "")
+	} else {
+		v.ancestors = []*element{p}
+		v.validateContent(p, p.tag)
+	}
+	return v.errs
+}
+
+// #region categories
+
+// category is a bit set of the content categories of an element.
+type category uint
+
+const (
+	metadataContent category = 1 << iota
+	flowContent
+	phrasingContent
+	headingContent
+	sectioningContent
+	interactiveContent
+)
+
+// contentCategories are the categories of every element, except those that
+// depend on their attributes, which are added by categoriesOf.
+var contentCategories = map[string]category{
+	"a":               flowContent | phrasingContent | interactiveContent,
+	"abbr":            flowContent | phrasingContent,
+	"address":         flowContent,
+	"area":            flowContent | phrasingContent,
+	"article":         flowContent | sectioningContent,
+	"aside":           flowContent | sectioningContent,
+	"audio":           flowContent | phrasingContent,
+	"b":               flowContent | phrasingContent,
+	"base":            metadataContent,
+	"bdi":             flowContent | phrasingContent,
+	"bdo":             flowContent | phrasingContent,
+	"blockquote":      flowContent,
+	"body":            0,
+	"br":              flowContent | phrasingContent,
+	"button":          flowContent | phrasingContent | interactiveContent,
+	"canvas":          flowContent | phrasingContent,
+	"caption":         0,
+	"cite":            flowContent | phrasingContent,
+	"code":            flowContent | phrasingContent,
+	"col":             0,
+	"colgroup":        0,
+	"data":            flowContent | phrasingContent,
+	"datalist":        flowContent | phrasingContent,
+	"dd":              0,
+	"del":             flowContent | phrasingContent,
+	"details":         flowContent | interactiveContent,
+	"dfn":             flowContent | phrasingContent,
+	"dialog":          flowContent,
+	"div":             flowContent,
+	"dl":              flowContent,
+	"dt":              0,
+	"em":              flowContent | phrasingContent,
+	"embed":           flowContent | phrasingContent | interactiveContent,
+	"fieldset":        flowContent,
+	"fencedframe":     flowContent | phrasingContent | interactiveContent,
+	"figcaption":      0,
+	"figure":          flowContent,
+	"footer":          flowContent,
+	"form":            flowContent,
+	"h1":              flowContent | headingContent,
+	"h2":              flowContent | headingContent,
+	"h3":              flowContent | headingContent,
+	"h4":              flowContent | headingContent,
+	"h5":              flowContent | headingContent,
+	"h6":              flowContent | headingContent,
+	"head":            0,
+	"header":          flowContent,
+	"hgroup":          flowContent | headingContent,
+	"hr":              flowContent,
+	"html":            0,
+	"i":               flowContent | phrasingContent,
+	"iframe":          flowContent | phrasingContent | interactiveContent,
+	"img":             flowContent | phrasingContent,
+	"input":           flowContent | phrasingContent,
+	"ins":             flowContent | phrasingContent,
+	"kbd":             flowContent | phrasingContent,
+	"label":           flowContent | phrasingContent | interactiveContent,
+	"legend":          0,
+	"li":              0,
+	"link":            metadataContent,
+	"main":            flowContent,
+	"map":             flowContent | phrasingContent,
+	"mark":            flowContent | phrasingContent,
+	"math":            flowContent | phrasingContent,
+	"menu":            flowContent,
+	"meta":            metadataContent,
+	"meter":           flowContent | phrasingContent,
+	"nav":             flowContent | sectioningContent,
+	"noscript":        metadataContent | flowContent | phrasingContent,
+	"object":          flowContent | phrasingContent,
+	"ol":              flowContent,
+	"optgroup":        0,
+	"option":          0,
+	"output":          flowContent | phrasingContent,
+	"p":               flowContent,
+	"picture":         flowContent | phrasingContent,
+	"pre":             flowContent,
+	"progress":        flowContent | phrasingContent,
+	"q":               flowContent | phrasingContent,
+	"rp":              0,
+	"rt":              0,
+	"ruby":            flowContent | phrasingContent,
+	"s":               flowContent | phrasingContent,
+	"samp":            flowContent | phrasingContent,
+	"script":          metadataContent | flowContent | phrasingContent,
+	"search":          flowContent,
+	"section":         flowContent | sectioningContent,
+	"select":          flowContent | phrasingContent | interactiveContent,
+	"selectedcontent": 0,
+	"slot":            flowContent | phrasingContent,
+	"small":           flowContent | phrasingContent,
+	"source":          0,
+	"span":            flowContent | phrasingContent,
+	"strong":          flowContent | phrasingContent,
+	"style":           metadataContent,
+	"sub":             flowContent | phrasingContent,
+	"summary":         0,
+	"sup":             flowContent | phrasingContent,
+	"svg":             flowContent | phrasingContent,
+	"table":           flowContent,
+	"tbody":           0,
+	"td":              0,
+	"template":        metadataContent | flowContent | phrasingContent,
+	"textarea":        flowContent | phrasingContent | interactiveContent,
+	"tfoot":           0,
+	"th":              0,
+	"thead":           0,
+	"time":            flowContent | phrasingContent,
+	"title":           metadataContent,
+	"tr":              0,
+	"track":           0,
+	"u":               flowContent | phrasingContent,
+	"ul":              flowContent,
+	"var":             flowContent | phrasingContent,
+	"video":           flowContent | phrasingContent,
+	"wbr":             flowContent | phrasingContent,
+}
+
+// categoriesOf returns the categories of the element, including those that
+// depend on its attributes. The unknown elements, like custom elements, are
+// flow and phrasing content.
+func categoriesOf(el *element) category {
+	c, ok := contentCategories[el.tag]
+	if !ok {
+		return flowContent | phrasingContent
+	}
+
+	has := func(name string) bool {
+		_, ok := el.attributeValue(name)
+		return ok
+	}
+
+	switch el.tag {
+	case "a":
+		if !has("href") {
+			c &^= interactiveContent
+		}
+	case "audio", "video":
+		if has("controls") {
+			c |= interactiveContent
+		}
+	case "img":
+		if has("usemap") {
+			c |= interactiveContent
+		}
+	case "input":
+		if v, _ := el.attributeValue("type"); !strings.EqualFold(v, "hidden") {
+			c |= interactiveContent
+		}
+	case "link", "meta":
+		// allowed in the body with microdata
+		if has("itemprop") {
+			c |= flowContent | phrasingContent
+		}
+	}
+
+	return c
+}
+
+// #region content models
+
+// contentModel describes the children allowed in an element.
+type contentModel struct {
+	// allow are the categories of the allowed children and tags the allowed
+	// elements that don't belong to them.
+	allow category
+	tags  []string
+
+	// text reports whether the element can contain text.
+	text bool
+
+	// transparent elements have the content model of their parent.
+	transparent bool
+
+	// foreign elements, like <svg>, have content from other namespaces that
+	// is not checked.
+	foreign bool
+
+	// exclude are the categories and tags not allowed as descendants.
+	exclude     category
+	excludeTags []string
+}
+
+var (
+	flowModel     = contentModel{allow: flowContent, text: true}
+	phrasingModel = contentModel{allow: phrasingContent, text: true}
+	textModel     = contentModel{text: true}
+	emptyModel    = contentModel{}
+
+	// scripts are allowed almost everywhere.
+	scriptTags = []string{"script", "template"}
+)
+
+// contentModels are the content models of every element. The unknown
+// elements, like custom elements, use flowModel.
+var contentModels = map[string]contentModel{
+	"html":     {tags: []string{"head", "body"}},
+	"head":     {allow: metadataContent},
+	"title":    textModel,
+	"style":    textModel,
+	"script":   textModel,
+	"noscript": {transparent: true},
+	"template": {allow: metadataContent | flowContent | phrasingContent, text: true, tags: contentTags()},
+	"base":     emptyModel,
+	"link":     emptyModel,
+	"meta":     emptyModel,
+
+	"body":       flowModel,
+	"main":       flowModel,
+	"article":    flowModel,
+	"aside":      flowModel,
+	"nav":        flowModel,
+	"section":    flowModel,
+	"search":     flowModel,
+	"div":        flowModel,
+	"blockquote": flowModel,
+	"figure":     {allow: flowContent, text: true, tags: []string{"figcaption"}},
+	"figcaption": flowModel,
+	"dialog":     flowModel,
+	"details":    {allow: flowContent, text: true, tags: []string{"summary"}},
+	"summary":    {allow: phrasingContent | headingContent, text: true},
+	"li":         flowModel,
+	"dd":         flowModel,
+	"dt":         {allow: flowContent, text: true, exclude: headingContent | sectioningContent, excludeTags: []string{"header", "footer"}},
+	"header":     {allow: flowContent, text: true, excludeTags: []string{"header", "footer"}},
+	"footer":     {allow: flowContent, text: true, excludeTags: []string{"header", "footer"}},
+	"address":    {allow: flowContent, text: true, exclude: headingContent | sectioningContent, excludeTags: []string{"header", "footer", "address"}},
+	"form":       {allow: flowContent, text: true, excludeTags: []string{"form"}},
+	"fieldset":   {allow: flowContent, text: true, tags: []string{"legend"}},
+	"legend":     {allow: phrasingContent | headingContent, text: true},
+
+	"p":      phrasingModel,
+	"h1":     phrasingModel,
+	"h2":     phrasingModel,
+	"h3":     phrasingModel,
+	"h4":     phrasingModel,
+	"h5":     phrasingModel,
+	"h6":     phrasingModel,
+	"hgroup": {tags: append([]string{"p", "h1", "h2", "h3", "h4", "h5", "h6"}, scriptTags...)},
+	"pre":    phrasingModel,
+	"hr":     emptyModel,
+	"br":     emptyModel,
+	"wbr":    emptyModel,
+
+	"ul":   {tags: append([]string{"li"}, scriptTags...)},
+	"ol":   {tags: append([]string{"li"}, scriptTags...)},
+	"menu": {tags: append([]string{"li"}, scriptTags...)},
+	"dl":   {tags: append([]string{"dt", "dd", "div"}, scriptTags...)},
+
+	"a":      {transparent: true, exclude: interactiveContent, excludeTags: []string{"a"}},
+	"abbr":   phrasingModel,
+	"b":      phrasingModel,
+	"bdi":    phrasingModel,
+	"bdo":    phrasingModel,
+	"cite":   phrasingModel,
+	"code":   phrasingModel,
+	"data":   phrasingModel,
+	"dfn":    {allow: phrasingContent, text: true, excludeTags: []string{"dfn"}},
+	"em":     phrasingModel,
+	"i":      phrasingModel,
+	"kbd":    phrasingModel,
+	"mark":   phrasingModel,
+	"q":      phrasingModel,
+	"rp":     textModel,
+	"rt":     phrasingModel,
+	"ruby":   {allow: phrasingContent, text: true, tags: []string{"rt", "rp"}},
+	"s":      phrasingModel,
+	"samp":   phrasingModel,
+	"small":  phrasingModel,
+	"span":   phrasingModel,
+	"strong": phrasingModel,
+	"sub":    phrasingModel,
+	"sup":    phrasingModel,
+	"time":   phrasingModel,
+	"u":      phrasingModel,
+	"var":    phrasingModel,
+	"ins":    {transparent: true},
+	"del":    {transparent: true},
+
+	"area":        emptyModel,
+	"img":         emptyModel,
+	"map":         {transparent: true},
+	"track":       emptyModel,
+	"audio":       {transparent: true, tags: []string{"source", "track"}, excludeTags: []string{"audio", "video"}},
+	"video":       {transparent: true, tags: []string{"source", "track"}, excludeTags: []string{"audio", "video"}},
+	"embed":       emptyModel,
+	"iframe":      emptyModel,
+	"fencedframe": emptyModel,
+	"object":      {transparent: true},
+	"picture":     {tags: append([]string{"source", "img"}, scriptTags...)},
+	"source":      emptyModel,
+	"svg":         {foreign: true},
+	"math":        {foreign: true},
+	"canvas":      {transparent: true, exclude: interactiveContent},
+	"slot":        {transparent: true},
+
+	"caption":  {allow: flowContent, text: true, excludeTags: []string{"table"}},
+	"col":      emptyModel,
+	"colgroup": {tags: []string{"col", "template"}},
+	"table":    {tags: append([]string{"caption", "colgroup", "thead", "tbody", "tfoot", "tr"}, scriptTags...)},
+	"thead":    {tags: append([]string{"tr"}, scriptTags...)},
+	"tbody":    {tags: append([]string{"tr"}, scriptTags...)},
+	"tfoot":    {tags: append([]string{"tr"}, scriptTags...)},
+	"tr":       {tags: append([]string{"td", "th"}, scriptTags...)},
+	"td":       flowModel,
+	"th":       {allow: flowContent, text: true, exclude: headingContent | sectioningContent, excludeTags: []string{"header", "footer"}},
+
+	"button":          {allow: phrasingContent, text: true, tags: []string{"selectedcontent"}, exclude: interactiveContent},
+	"datalist":        {allow: phrasingContent, text: true, tags: []string{"option"}},
+	"input":           emptyModel,
+	"label":           {allow: phrasingContent, text: true, excludeTags: []string{"label"}},
+	"meter":           {allow: phrasingContent, text: true, excludeTags: []string{"meter"}},
+	"optgroup":        {tags: append([]string{"option"}, scriptTags...)},
+	"option":          textModel,
+	"output":          phrasingModel,
+	"progress":        {allow: phrasingContent, text: true, excludeTags: []string{"progress"}},
+	"select":          {tags: append([]string{"button", "option", "optgroup", "hr"}, scriptTags...)},
+	"selectedcontent": emptyModel,
+	"textarea":        textModel,
+}
+
+// contentTags returns the elements without categories, which are only allowed
+// in some parents. A <template> can hold any of them.
+func contentTags() []string {
+	var tags []string
+	for tag, c := range contentCategories {
+		if c == 0 {
+			tags = append(tags, tag)
+		}
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+func modelOf(tag string) contentModel {
+	if m, ok := contentModels[tag]; ok {
+		return m
+	}
+	return flowModel
+}
+
+// #region validator
+
+type validator struct {
+	// ancestors are the open elements, the last one is the parent of the
+	// validated children.
+	ancestors []*element
+	errs      []ValidationError
+}
+
+func (v *validator) addError(path, tag, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Tag: tag, Message: fmt.Sprintf(format, args...)})
+}
+
+// validateContent validates the children of el, whose path is path.
+func (v *validator) validateContent(el *element, path string) {
+	if modelOf(el.tag).foreign {
+		return
+	}
+
+	siblings := el.children()
+	v.validateText(el, path)
+
+	for i, n := range siblings {
+		child := n.el
+		childPath := stepPath(child.tag, i, siblings)
+		if path != "" {
+			childPath = path + " > " + childPath
+		}
+
+		v.validateChild(child, childPath)
+
+		v.ancestors = append(v.ancestors, child)
+		v.validateContent(child, childPath)
+		v.ancestors = v.ancestors[:len(v.ancestors)-1]
+	}
+}
+
+// stepPath returns the step of the path of the element at the position i of
+// siblings. The position is only included when a sibling has the same tag.
+func stepPath(tag string, i int, siblings []node) string {
+	for j, s := range siblings {
+		if j != i && s.el.tag == tag {
+			return tag + ":nth-child(" + strconv.Itoa(i+1) + ")"
+		}
+	}
+	return tag
+}
+
+// validateText reports the texts of el, including those of the elements
+// without tags, when its content model doesn't allow them.
+func (v *validator) validateText(el *element, path string) {
+	for _, c := range el.content {
+		if e, ok := c.(elementer); ok && e.elem().tag == "" {
+			v.validateText(e.elem(), path)
+			continue
+		}
+
+		var text string
+		switch t := c.(type) {
+		case *textEntity:
+			text = t.content
+		case *escapeStringEntity:
+			text = t.content
+		default:
+			continue
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if parent, allowed := v.allowsText(); !allowed {
+			v.addError(path, "", "text is not allowed in <%s>", parent)
+			return
+		}
+	}
+}
+
+// allowsText reports whether the open elements allow a text, and the element
+// whose content model decides it.
+func (v *validator) allowsText() (string, bool) {
+	for i := len(v.ancestors) - 1; i >= 0; i-- {
+		m := modelOf(v.ancestors[i].tag)
+		if !m.transparent {
+			return v.ancestors[i].tag, m.text
+		}
+	}
+	return "", true
+}
+
+// inListGroup reports whether tag is a <dt> or a <dd> in the <div> at the
+// position i of the open elements that groups them in a <dl>. The parent of
+// the validated element is unknown, so its <div> is allowed to group them.
+func (v *validator) inListGroup(i int, tag string) bool {
+	if (tag != "dt" && tag != "dd") || v.ancestors[i].tag != "div" {
+		return false
+	}
+	return i == 0 || v.ancestors[i-1].tag == "dl"
+}
+
+// validateChild checks child against the content models of the open
+// elements.
+func (v *validator) validateChild(child *element, path string) {
+	c := categoriesOf(child)
+
+	for i := len(v.ancestors) - 1; i >= 0; i-- {
+		parent := v.ancestors[i].tag
+		m := modelOf(parent)
+		if slices.Contains(m.tags, child.tag) || v.inListGroup(i, child.tag) {
+			break
+		}
+		if m.transparent {
+			continue
+		}
+		if m.allow&c == 0 {
+			v.addError(path, child.tag, "<%s> is not allowed in <%s>", child.tag, parent)
+		}
+		break
+	}
+
+	for i := len(v.ancestors) - 1; i >= 0; i-- {
+		ancestor := v.ancestors[i].tag
+		m := modelOf(ancestor)
+		if m.exclude&c != 0 || slices.Contains(m.excludeTags, child.tag) {
+			v.addError(path, child.tag, "<%s> is not allowed inside <%s>", child.tag, ancestor)
+			return
+		}
+	}
+}
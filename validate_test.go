@@ -0,0 +1,65 @@
+package renderHTML
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		root interface{ Validate() []ValidationError }
+		want []string
+	}{
+		{"valid", Html(Head(Title("t"), Meta().CharSet("utf-8")), Body(
+			Ul(Li(A("a").Href("/")), Li(P("b"))),
+			Table(Thead(Tr(Th("h"))), Tbody(Tr(Td(Div("d"))))),
+			P("x ", A(Em("y")).Href("/y"), Button("z")),
+			A(Div("block link")).Href("/"),
+			Dl(Div(Dt("t"), Dd("d"))),
+			Form(Label("n", Input().Name("n"))),
+		)), nil},
+		{"li in div", Div(Li("a")), []string{"div > li: <li> is not allowed in <div>"}},
+		{"div in p", Body(P("a", Div("b")), P("c")), []string{"body > p:nth-child(1) > div: <div> is not allowed in <p>"}},
+		{"text", Ul("text", Li("a")), []string{"ul: text is not allowed in <ul>"}},
+		{"form in form", Form(Div(Form())), []string{"form > div > form: <form> is not allowed inside <form>"}},
+		{"button in a", A(Span(Button("b"))).Href("/"), []string{"a > span > button: <button> is not allowed inside <a>"}},
+		{"transparent", P(A(Div("x")).Href("/")), []string{"p > a > div: <div> is not allowed in <p>"}},
+		{"head", Head(Div()), []string{"head > div: <div> is not allowed in <head>"}},
+		{"container", Container(Tr(Td("a")), Ul(Container(P("b")))), []string{"ul > p: <p> is not allowed in <ul>"}},
+		{"dt in div", Body(Div(Dt("t"), Dd("d"))), []string{"body > div > dt: <dt> is not allowed in <div>", "body > div > dd: <dd> is not allowed in <div>"}},
+		{"dt in group", Div(Dt("t"), Dd("d")), nil},
+		{"table", Table(Td("a")), []string{"table > td: <td> is not allowed in <table>"}},
+		{"custom", Span(newCustomElement("my-card", Div())), nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, err := range tt.root.Validate() {
+			got = append(got, err.Error())
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%v: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	body := Body(P("a"), P(Div("b")))
+	errs := body.Validate()
+	if len(errs) != 1 {
+		t.Fatalf("got %v errors, want 1", len(errs))
+	}
+	if found, err := body.Query(errs[0].Path); err != nil || found == nil || found.String() != "<div>b</div>" {
+		t.Errorf("Query(%q): got %v, %v", errs[0].Path, found, err)
+	}
+}
+
+func TestContentModels(t *testing.T) {
+	for tag := range parseConstructors {
+		if _, ok := contentModels[tag]; !ok {
+			t.Errorf("missing content model of <%s>", tag)
+		}
+		if _, ok := contentCategories[tag]; !ok {
+			t.Errorf("missing content categories of <%s>", tag)
+		}
+	}
+}